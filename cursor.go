@@ -0,0 +1,172 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+// Cursor specifies position for cursor pagination.
+// Backward cursor points to items before the key, otherwise to items after the key.
+type Cursor[K any] struct {
+	Key      K
+	Backward bool
+}
+
+type cursorOptions struct {
+	PageSize   int
+	TokenCodec *token.Codec
+}
+
+func (o *cursorOptions) validate() error {
+	if o.PageSize <= 0 {
+		return fmt.Errorf("%w: page size %d", ErrInvalidOptions, o.PageSize)
+	}
+
+	return nil
+}
+
+// CursorOption specify option for CursorPaginator.
+type CursorOption func(opts *cursorOptions)
+
+// WithCursorPageSize page size for CursorPaginator (default 10).
+func WithCursorPageSize(size int) CursorOption {
+	return func(opts *cursorOptions) {
+		opts.PageSize = size
+	}
+}
+
+// WithCursorTokenCodec codec for CursorPaginator.PageByToken page tokens.
+func WithCursorTokenCodec(codec *token.Codec) CursorOption {
+	return func(opts *cursorOptions) {
+		opts.TokenCodec = codec
+	}
+}
+
+// CursorPaginator structure.
+type CursorPaginator[T any, K any] struct {
+	queryer  CursorQueryer[T, K]
+	pageSize int
+	opts     cursorOptions
+}
+
+// NewCursor construct cursor paginator.
+// panics on invalid options, use NewCursorWithOptions for error handling.
+func NewCursor[T any, K any](
+	queryer CursorQueryer[T, K],
+	pageSize int,
+	opts ...CursorOption,
+) *CursorPaginator[T, K] {
+	paginator, err := NewCursorWithOptions(queryer, append([]CursorOption{WithCursorPageSize(pageSize)}, opts...)...)
+	if err != nil {
+		panic(err)
+	}
+
+	return paginator
+}
+
+// NewCursorWithOptions construct cursor paginator and validates options.
+func NewCursorWithOptions[T any, K any](
+	queryer CursorQueryer[T, K],
+	opts ...CursorOption,
+) (*CursorPaginator[T, K], error) {
+	defaultOptions := cursorOptions{
+		PageSize: defaultPageSize,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	if err := defaultOptions.validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %w", err)
+	}
+
+	return &CursorPaginator[T, K]{
+		queryer:  queryer,
+		pageSize: defaultOptions.PageSize,
+		opts:     defaultOptions,
+	}, nil
+}
+
+// Page returns information about page located by cursor.
+// nil cursor means first page.
+func (p *CursorPaginator[T, K]) Page(ctx context.Context, cursor *Cursor[K]) (*CursorPage[T, K], error) {
+	if cursor == nil {
+		return p.pageAfter(ctx, nil)
+	}
+
+	if cursor.Backward {
+		return p.pageBefore(ctx, cursor.Key)
+	}
+
+	return p.pageAfter(ctx, &cursor.Key)
+}
+
+// pageAfter requests one extra item to find out whether next page is available.
+func (p *CursorPaginator[T, K]) pageAfter(ctx context.Context, key *K) (*CursorPage[T, K], error) {
+	data, err := p.queryer.QueryAfter(ctx, key, p.pageSize+1)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query after",
+			Err: err,
+		}
+	}
+
+	hasNext := len(data) > p.pageSize
+	if hasNext {
+		data = data[:p.pageSize]
+	}
+
+	return p.makePage(data, hasNext, key != nil), nil
+}
+
+// pageBefore requests one extra item to find out whether previous page is available.
+// falls back to the first page if there are no items before the key.
+func (p *CursorPaginator[T, K]) pageBefore(ctx context.Context, key K) (*CursorPage[T, K], error) {
+	data, err := p.queryer.QueryBefore(ctx, key, p.pageSize+1)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query before",
+			Err: err,
+		}
+	}
+
+	if len(data) == 0 {
+		return p.pageAfter(ctx, nil)
+	}
+
+	hasPrevious := len(data) > p.pageSize
+	if hasPrevious {
+		data = data[len(data)-p.pageSize:]
+	}
+
+	return p.makePage(data, true, hasPrevious), nil
+}
+
+func (p *CursorPaginator[T, K]) makePage(data []T, hasNext bool, hasPrevious bool) *CursorPage[T, K] {
+	page := &CursorPage[T, K]{
+		Data:     data,
+		PageSize: len(data),
+	}
+
+	if len(data) == 0 {
+		return page
+	}
+
+	if hasNext {
+		page.NextCursor = &Cursor[K]{
+			Key: p.queryer.Key(data[len(data)-1]),
+		}
+	}
+
+	if hasPrevious {
+		page.PreviousCursor = &Cursor[K]{
+			Key:      p.queryer.Key(data[0]),
+			Backward: true,
+		}
+	}
+
+	return page
+}
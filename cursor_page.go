@@ -0,0 +1,32 @@
+package paginator
+
+// CursorPage represents single cursor page information.
+// NextPageToken and PreviousPageToken are set by PageByToken for available pages.
+type CursorPage[T any, K any] struct {
+	Data              []T
+	PageSize          int
+	NextCursor        *Cursor[K]
+	PreviousCursor    *Cursor[K]
+	NextPageToken     string
+	PreviousPageToken string
+}
+
+// HasNext returns true if next page is available.
+func (p *CursorPage[T, K]) HasNext() bool {
+	return p.NextCursor != nil
+}
+
+// Next returns cursor for the next page or nil if next page is not available.
+func (p *CursorPage[T, K]) Next() *Cursor[K] {
+	return p.NextCursor
+}
+
+// HasPrevious returns true if previous page is available.
+func (p *CursorPage[T, K]) HasPrevious() bool {
+	return p.PreviousCursor != nil
+}
+
+// Previous returns cursor for the previous page or nil if previous page is not available.
+func (p *CursorPage[T, K]) Previous() *Cursor[K] {
+	return p.PreviousCursor
+}
@@ -0,0 +1,76 @@
+package paginator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+// PageByToken returns cursor page by opaque token issued by previous PageByToken call.
+// empty token means the first page.
+// filterHash binds tokens to the request filter, token issued for another filter is rejected.
+// returned page contains tokens for the next and the previous pages.
+func (p *CursorPaginator[T, K]) PageByToken(
+	ctx context.Context,
+	pageToken string,
+	filterHash string,
+) (*CursorPage[T, K], error) {
+	if p.opts.TokenCodec == nil {
+		return nil, ErrNoTokenCodec
+	}
+
+	var cursor *Cursor[K]
+
+	if pageToken != "" {
+		state, err := p.opts.TokenCodec.Decode(pageToken, filterHash)
+		if err != nil {
+			return nil, fmt.Errorf("decode token: %w", err)
+		}
+
+		cursor = new(Cursor[K])
+
+		if err := json.Unmarshal(state.Cursor, cursor); err != nil {
+			return nil, fmt.Errorf("%w: unmarshal cursor: %s", token.ErrInvalidToken, err.Error())
+		}
+	}
+
+	page, err := p.Page(ctx, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.NextPageToken, err = p.encodeCursor(page.NextCursor, filterHash); err != nil {
+		return nil, fmt.Errorf("encode next cursor: %w", err)
+	}
+
+	if page.PreviousPageToken, err = p.encodeCursor(page.PreviousCursor, filterHash); err != nil {
+		return nil, fmt.Errorf("encode previous cursor: %w", err)
+	}
+
+	return page, nil
+}
+
+// encodeCursor returns token for the cursor or empty string for nil cursor.
+func (p *CursorPaginator[T, K]) encodeCursor(cursor *Cursor[K], filterHash string) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	rawCursor, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("marshal cursor: %w", err)
+	}
+
+	pageToken, err := p.opts.TokenCodec.Encode(token.State{
+		Cursor:     rawCursor,
+		Size:       p.pageSize,
+		FilterHash: filterHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode token: %w", err)
+	}
+
+	return pageToken, nil
+}
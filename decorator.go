@@ -0,0 +1,34 @@
+package paginator
+
+import (
+	"context"
+)
+
+// QueryerFuncs adapts functions to Queryer interface.
+type QueryerFuncs[T any] struct {
+	QueryFn func(ctx context.Context, offset int, limit int) ([]T, error)
+	CountFn func(ctx context.Context) (int, error)
+}
+
+// Query calls QueryFn.
+func (q QueryerFuncs[T]) Query(ctx context.Context, offset int, limit int) ([]T, error) {
+	return q.QueryFn(ctx, offset, limit)
+}
+
+// Count calls CountFn.
+func (q QueryerFuncs[T]) Count(ctx context.Context) (int, error) {
+	return q.CountFn(ctx)
+}
+
+// Decorator wraps Queryer adding behaviour like caching, logging, retries or metrics.
+type Decorator[T any] func(queryer Queryer[T]) Queryer[T]
+
+// Chain wraps queryer with decorators.
+// the first decorator is the outermost one and receives calls first.
+func Chain[T any](queryer Queryer[T], decorators ...Decorator[T]) Queryer[T] {
+	for i := len(decorators) - 1; i >= 0; i-- {
+		queryer = decorators[i](queryer)
+	}
+
+	return queryer
+}
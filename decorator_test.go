@@ -0,0 +1,88 @@
+package paginator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryercache"
+)
+
+func TestQueryerFuncs(t *testing.T) {
+	t.Parallel()
+
+	pag := paginator.New(paginator.QueryerFuncs[int]{
+		QueryFn: func(ctx context.Context, offset int, limit int) ([]int, error) {
+			return []int{offset, limit}, nil
+		},
+		CountFn: func(ctx context.Context) (int, error) {
+			return 5, nil
+		},
+	}, 2)
+
+	page, err := pag.Page(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2}, page.Data)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func recordingDecorator(name string, calls *[]string) paginator.Decorator[int] {
+	return func(queryer paginator.Queryer[int]) paginator.Queryer[int] {
+		return paginator.QueryerFuncs[int]{
+			QueryFn: func(ctx context.Context, offset int, limit int) ([]int, error) {
+				*calls = append(*calls, name+" query")
+
+				return queryer.Query(ctx, offset, limit)
+			},
+			CountFn: func(ctx context.Context) (int, error) {
+				*calls = append(*calls, name+" count")
+
+				return queryer.Count(ctx)
+			},
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls   []string
+		queryer = paginator.Chain(
+			paginator.QueryerFuncs[int]{
+				QueryFn: func(ctx context.Context, offset int, limit int) ([]int, error) {
+					calls = append(calls, "base query")
+
+					return []int{1, 2, 3}, nil
+				},
+				CountFn: func(ctx context.Context) (int, error) {
+					calls = append(calls, "base count")
+
+					return 3, nil
+				},
+			},
+			recordingDecorator("outer", &calls),
+			queryercache.Decorator[int](queryercache.WithCountTTL(time.Minute), queryercache.WithQueryTTL(time.Minute)),
+			recordingDecorator("inner", &calls),
+		)
+		pag = paginator.New(queryer, 10)
+	)
+
+	for range 2 {
+		page, err := pag.Page(t.Context(), 1)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, page.Data)
+	}
+
+	require.Equal(t, []string{
+		"outer count", "inner count", "base count",
+		"outer query", "inner query", "base query",
+		"outer count",
+		"outer query",
+	}, calls)
+}
@@ -0,0 +1,86 @@
+package paginator
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidPage is returned for page number less than the first page.
+	ErrInvalidPage = errors.New("invalid page number")
+	// ErrPageOutOfRange is returned for page number past the last page.
+	ErrPageOutOfRange = errors.New("page out of range")
+	// ErrInvalidRange is returned for negative offset or non positive limit.
+	ErrInvalidRange = errors.New("invalid range")
+	// ErrItemNotFound is returned by PageOf if there is no item with key.
+	ErrItemNotFound = errors.New("item not found")
+	// ErrPositionNotSupported is returned by PageOf if queryer doesn't implement PositionQueryer.
+	ErrPositionNotSupported = errors.New("queryer doesn't support position")
+	// ErrInvalidOptions is returned by NewWithOptions for invalid paginator options.
+	ErrInvalidOptions = errors.New("invalid options")
+	// ErrNoTokenCodec is returned by PageByToken if token codec is not specified.
+	ErrNoTokenCodec = errors.New("token codec is not specified")
+)
+
+// PageRangeError describes requested page past the last page.
+// TotalUnknown is set when total pages is unknown (e.g. page requested without count).
+// Matches ErrPageOutOfRange with errors.Is.
+type PageRangeError struct {
+	Page         int
+	TotalPages   int
+	TotalUnknown bool
+}
+
+// Error implements error interface.
+func (e *PageRangeError) Error() string {
+	if e.TotalUnknown {
+		return fmt.Sprintf("invalid page: %d no data", e.Page)
+	}
+
+	return fmt.Sprintf("invalid page: %d total pages: %d", e.Page, e.TotalPages)
+}
+
+// Unwrap returns ErrPageOutOfRange.
+func (e *PageRangeError) Unwrap() error {
+	return ErrPageOutOfRange
+}
+
+// RangeError describes requested offset past the last item.
+// TotalUnknown is set when total items is unknown (e.g. range requested without count).
+// Matches ErrPageOutOfRange with errors.Is.
+type RangeError struct {
+	Offset         int
+	ItemTotalCount int
+	TotalUnknown   bool
+}
+
+// Error implements error interface.
+func (e *RangeError) Error() string {
+	if e.TotalUnknown {
+		return fmt.Sprintf("invalid offset: %d no data", e.Offset)
+	}
+
+	return fmt.Sprintf("invalid offset: %d total items: %d", e.Offset, e.ItemTotalCount)
+}
+
+// Unwrap returns ErrPageOutOfRange.
+func (e *RangeError) Unwrap() error {
+	return ErrPageOutOfRange
+}
+
+// QueryError wraps errors returned by Queryer implementations.
+// Op specifies failed operation.
+type QueryError struct {
+	Op  string
+	Err error
+}
+
+// Error implements error interface.
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Op, e.Err.Error())
+}
+
+// Unwrap returns underlying Queryer error.
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -28,9 +29,20 @@ func (h *Handler) TestTablePage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	page, err := h.paginatorProvider.Page(r.Context(), pageID)
+	var pageSize int
+
+	if size := r.URL.Query().Get("size"); size != "" {
+		pageSize, err = strconv.Atoi(size)
+		if err != nil {
+			http.Error(w, "invalid page size", http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	page, err := h.paginatorProvider.PageWithSize(r.Context(), pageID, pageSize)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("paginator error: %s", err.Error()), http.StatusInternalServerError)
+		http.Error(w, fmt.Sprintf("paginator error: %s", err.Error()), errorStatusCode(err))
 
 		return
 	}
@@ -40,10 +52,45 @@ func (h *Handler) TestTablePage(w http.ResponseWriter, r *http.Request) {
 	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "\t")
 
-	//nolint:musttag
-	if err := encoder.Encode(page.Data); err != nil {
+	if err := encoder.Encode(page); err != nil {
 		http.Error(w, "encode page data error", http.StatusInternalServerError)
 
 		return
 	}
 }
+
+//nolint:varnamelen
+func (h *Handler) TestTableItemLocation(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid item id", http.StatusBadRequest)
+
+		return
+	}
+
+	location, err := paginator.PageOf[TestTable](r.Context(), h.paginatorProvider, itemID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("paginator error: %s", err.Error()), errorStatusCode(err))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(location); err != nil {
+		http.Error(w, "encode item location error", http.StatusInternalServerError)
+
+		return
+	}
+}
+
+func errorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, paginator.ErrInvalidPage):
+		return http.StatusBadRequest
+	case errors.Is(err, paginator.ErrPageOutOfRange), errors.Is(err, paginator.ErrItemNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -51,7 +51,7 @@ func main() {
 	}
 
 	handler := NewHandler(paginator.New(
-		queryercache.New(
+		queryercache.NewPosition(
 			postgres,
 			queryercache.WithCountTTL(time.Minute),
 			queryercache.WithQueryTTL(time.Minute),
@@ -61,6 +61,7 @@ func main() {
 	))
 
 	http.HandleFunc("GET /page/{id}/", handler.TestTablePage)
+	http.HandleFunc("GET /item/{id}/location/", handler.TestTableItemLocation)
 	http.Handle("GET /metrics/", promhttp.Handler())
 
 	//nolint:gosec
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 )
 
@@ -124,3 +125,29 @@ func (p *PostgresQueryProvider) Count(ctx context.Context) (int, error) {
 
 	return count, nil
 }
+
+func (p *PostgresQueryProvider) Position(ctx context.Context, id int) (int, bool, error) {
+	var position int
+
+	if err := p.db.QueryRowContext(ctx, `
+		SELECT
+			position
+		FROM (
+			SELECT
+				id,
+				ROW_NUMBER() OVER (ORDER BY ordered_field) - 1 AS position
+			FROM
+				test
+		) positions
+		WHERE
+			id = $1
+	`, id).Scan(&position); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+
+		return 0, false, fmt.Errorf("query row context: %w", err)
+	}
+
+	return position, true, nil
+}
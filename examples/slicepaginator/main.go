@@ -17,15 +17,15 @@ func main() {
 	var (
 		ctx   = context.Background()
 		pagin = paginator.New(NewSliceProvider(), pageSize)
-		page  *paginator.Page[int]
 	)
 
-	page, _ = pagin.Page(ctx, 1)
+	for page, err := range pagin.Pages(ctx) {
+		if err != nil {
+			log.Printf("page error: %v", err)
 
-	printPage(page)
+			return
+		}
 
-	for page.HasNext() {
-		page, _ = pagin.Page(ctx, page.Next())
 		printPage(page)
 	}
 }
@@ -0,0 +1,238 @@
+// Package export provides streaming export of all paginator pages.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+// Column specifies csv column name and item value formatter.
+type Column[T any] struct {
+	Name  string
+	Value func(item T) string
+}
+
+// Progress describes export state after page is written.
+// ItemCount counts items written by current call, not including pages skipped by WithStartPage.
+type Progress struct {
+	Page           int
+	PageTotalCount int
+	ItemCount      int
+	ItemTotalCount int
+}
+
+// Error describes export failure with page to resume from.
+type Error struct {
+	Page int
+	Err  error
+}
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("export page %d: %v", e.Page, e.Err)
+}
+
+// Unwrap returns underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExportCSV writes all pages to w in csv format with header row.
+// every page is encoded in memory and written to w with single Write call,
+// on failure returns *Error with page to resume from (see WithStartPage).
+func ExportCSV[T any](
+	ctx context.Context,
+	pag *paginator.Paginator[T],
+	w io.Writer,
+	columns []Column[T],
+	opts ...Option,
+) error {
+	var (
+		defaultOptions = makeOptions(opts)
+		header         bytes.Buffer
+		record         = make([]string, len(columns))
+	)
+
+	if defaultOptions.StartPage == 1 {
+		for i, column := range columns {
+			record[i] = column.Name
+		}
+
+		if err := writeCSV(csv.NewWriter(&header), record); err != nil {
+			return &Error{
+				Page: defaultOptions.StartPage,
+				Err:  fmt.Errorf("encode header: %w", err),
+			}
+		}
+
+		if err := writeAll(w, header.Bytes()); err != nil {
+			return &Error{
+				Page: defaultOptions.StartPage,
+				Err:  fmt.Errorf("write header: %w", err),
+			}
+		}
+	}
+
+	return walk(ctx, pag, w, defaultOptions, func(buf *bytes.Buffer, page *paginator.Page[T]) error {
+		writer := csv.NewWriter(buf)
+
+		for _, item := range page.Data {
+			for i, column := range columns {
+				record[i] = column.Value(item)
+			}
+
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("write record: %w", err)
+			}
+		}
+
+		writer.Flush()
+
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("flush: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// writeCSV writes record and flushes it to the underlying writer.
+func writeCSV(writer *csv.Writer, record []string) error {
+	if err := writer.Write(record); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
+
+// ExportNDJSON writes all pages to w as newline delimited json, one item per line.
+// every page is encoded in memory and written to w with single Write call,
+// on failure returns *Error with page to resume from (see WithStartPage).
+func ExportNDJSON[T any](
+	ctx context.Context,
+	pag *paginator.Paginator[T],
+	w io.Writer,
+	opts ...Option,
+) error {
+	return walk(ctx, pag, w, makeOptions(opts), func(buf *bytes.Buffer, page *paginator.Page[T]) error {
+		encoder := json.NewEncoder(buf)
+
+		for _, item := range page.Data {
+			if err := encoder.Encode(item); err != nil {
+				return fmt.Errorf("encode item: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func makeOptions(opts []Option) options {
+	defaultOptions := options{
+		StartPage: 1,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	return defaultOptions
+}
+
+// walk encodes every page starting from options start page and writes it to w at once,
+// so page is either written or not, and resume from failed page doesn't duplicate items.
+func walk[T any](
+	ctx context.Context,
+	pag *paginator.Paginator[T],
+	w io.Writer,
+	opts options,
+	encode func(buf *bytes.Buffer, page *paginator.Page[T]) error,
+) error {
+	var (
+		itemCount = 0
+		buf       bytes.Buffer
+	)
+
+	for pageNumber := opts.StartPage; ; pageNumber++ {
+		if err := ctx.Err(); err != nil {
+			return &Error{
+				Page: pageNumber,
+				Err:  fmt.Errorf("context: %w", err),
+			}
+		}
+
+		page, err := pag.PageWithSize(ctx, pageNumber, opts.BatchSize)
+		if err != nil {
+			return &Error{
+				Page: pageNumber,
+				Err:  fmt.Errorf("page: %w", err),
+			}
+		}
+
+		// consistent empty page means empty collection or the end of data.
+		if page.IsEmpty() && !page.Inconsistent {
+			return nil
+		}
+
+		buf.Reset()
+
+		if err := encode(&buf, page); err != nil {
+			return &Error{
+				Page: pageNumber,
+				Err:  err,
+			}
+		}
+
+		if err := writeAll(w, buf.Bytes()); err != nil {
+			return &Error{
+				Page: pageNumber,
+				Err:  fmt.Errorf("write page: %w", err),
+			}
+		}
+
+		itemCount += len(page.Data)
+
+		if opts.Progress != nil {
+			opts.Progress(Progress{
+				Page:           pageNumber,
+				PageTotalCount: page.PageTotalCount,
+				ItemCount:      itemCount,
+				ItemTotalCount: page.ItemTotalCount,
+			})
+		}
+
+		if !page.HasNext() {
+			return nil
+		}
+	}
+}
+
+// writeAll writes data to w with single Write call.
+func writeAll(w io.Writer, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	n, err := w.Write(data)
+	if err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if n != len(data) {
+		return io.ErrShortWrite
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/export"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+type row struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func makeRows(count int) []row {
+	rows := make([]row, 0, count)
+
+	for i := range count {
+		rows = append(rows, row{
+			ID:   i + 1,
+			Name: "name" + strconv.Itoa(i+1),
+		})
+	}
+
+	return rows
+}
+
+func rowColumns() []export.Column[row] {
+	return []export.Column[row]{
+		{Name: "id", Value: func(r row) string { return strconv.Itoa(r.ID) }},
+		{Name: "name", Value: func(r row) string { return r.Name }},
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag      = paginator.New(queryerslice.New(makeRows(5)), 10)
+		buf      bytes.Buffer
+		progress []export.Progress
+	)
+
+	err := export.ExportCSV(t.Context(), pag, &buf, rowColumns(),
+		export.WithBatchSize(2),
+		export.WithProgress(func(p export.Progress) {
+			progress = append(progress, p)
+		}),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, "id,name\n1,name1\n2,name2\n3,name3\n4,name4\n5,name5\n", buf.String())
+	require.Equal(t, []export.Progress{
+		{Page: 1, PageTotalCount: 3, ItemCount: 2, ItemTotalCount: 5},
+		{Page: 2, PageTotalCount: 3, ItemCount: 4, ItemTotalCount: 5},
+		{Page: 3, PageTotalCount: 3, ItemCount: 5, ItemTotalCount: 5},
+	}, progress)
+}
+
+func TestExportNDJSON(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag = paginator.New(queryerslice.New(makeRows(3)), 2)
+		buf bytes.Buffer
+	)
+
+	err := export.ExportNDJSON(t.Context(), pag, &buf)
+
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"id":1,"name":"name1"}`+"\n"+`{"id":2,"name":"name2"}`+"\n"+`{"id":3,"name":"name3"}`+"\n",
+		buf.String(),
+	)
+}
+
+func TestExportEmpty(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag = paginator.New(queryerslice.New([]row{}), 2)
+		buf bytes.Buffer
+	)
+
+	err := export.ExportCSV(t.Context(), pag, &buf, rowColumns())
+
+	require.NoError(t, err)
+	require.Equal(t, "id,name\n", buf.String())
+}
+
+func TestExportResume(t *testing.T) {
+	t.Parallel()
+
+	var (
+		errQuery = errors.New("query error")
+		rows     = makeRows(5)
+		failing  = true
+		queryer  = paginator.QueryerFuncs[row]{
+			QueryFn: func(ctx context.Context, offset int, limit int) ([]row, error) {
+				if offset >= 2 && failing {
+					return nil, errQuery
+				}
+
+				return rows[offset : offset+limit], nil
+			},
+			CountFn: func(ctx context.Context) (int, error) {
+				return len(rows), nil
+			},
+		}
+		pag = paginator.New(queryer, 2)
+		buf bytes.Buffer
+	)
+
+	err := export.ExportCSV(t.Context(), pag, &buf, rowColumns())
+
+	var exportErr *export.Error
+
+	require.ErrorAs(t, err, &exportErr)
+	require.ErrorIs(t, err, errQuery)
+	require.Equal(t, 2, exportErr.Page)
+	require.Equal(t, "id,name\n1,name1\n2,name2\n", buf.String())
+
+	failing = false
+
+	err = export.ExportCSV(t.Context(), pag, &buf, rowColumns(), export.WithStartPage(exportErr.Page))
+
+	require.NoError(t, err)
+	require.Equal(t, "id,name\n1,name1\n2,name2\n3,name3\n4,name4\n5,name5\n", buf.String())
+}
+
+func TestExportContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag         = paginator.New(queryerslice.New(makeRows(5)), 2)
+		ctx, cancel = context.WithCancel(t.Context())
+		buf         bytes.Buffer
+	)
+
+	cancel()
+
+	err := export.ExportNDJSON(ctx, pag, &buf)
+
+	var exportErr *export.Error
+
+	require.ErrorAs(t, err, &exportErr)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, exportErr.Page)
+	require.Empty(t, buf.String())
+}
+
+type failingWriter struct {
+	buf    bytes.Buffer
+	writes int
+	failAt int
+}
+
+var errWrite = errors.New("write error")
+
+func (f *failingWriter) Write(data []byte) (int, error) {
+	f.writes++
+
+	if f.writes == f.failAt {
+		return 0, errWrite
+	}
+
+	return f.buf.Write(data)
+}
+
+func TestExportResumeAfterWriteError(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Export   func(w io.Writer, opts ...export.Option) error
+		FailAt   int
+		Expected string
+	}{
+		"ndjson": {
+			Export: func(w io.Writer, opts ...export.Option) error {
+				return export.ExportNDJSON(t.Context(), paginator.New(queryerslice.New(makeRows(6)), 3), w, opts...)
+			},
+			FailAt: 2,
+			Expected: `{"id":1,"name":"name1"}` + "\n" + `{"id":2,"name":"name2"}` + "\n" +
+				`{"id":3,"name":"name3"}` + "\n" + `{"id":4,"name":"name4"}` + "\n" +
+				`{"id":5,"name":"name5"}` + "\n" + `{"id":6,"name":"name6"}` + "\n",
+		},
+		"csv": {
+			Export: func(w io.Writer, opts ...export.Option) error {
+				return export.ExportCSV(t.Context(), paginator.New(queryerslice.New(makeRows(6)), 3), w, rowColumns(), opts...)
+			},
+			FailAt:   3,
+			Expected: "id,name\n1,name1\n2,name2\n3,name3\n4,name4\n5,name5\n6,name6\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			writer := &failingWriter{failAt: tc.FailAt}
+
+			err := tc.Export(writer)
+
+			var exportErr *export.Error
+
+			require.ErrorAs(t, err, &exportErr)
+			require.ErrorIs(t, err, errWrite)
+			require.Equal(t, 2, exportErr.Page)
+
+			err = tc.Export(writer, export.WithStartPage(exportErr.Page))
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, writer.buf.String())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package export
+
+type options struct {
+	BatchSize int
+	StartPage int
+	Progress  func(progress Progress)
+}
+
+// Option specify option for export functions.
+type Option func(opts *options)
+
+// WithBatchSize page size used for export (default paginator page size).
+func WithBatchSize(size int) Option {
+	return func(opts *options) {
+		opts.BatchSize = size
+	}
+}
+
+// WithStartPage page to start export from, used to resume after failure (default 1).
+// resumed export must use the same batch size, csv header is written only from the first page.
+func WithStartPage(page int) Option {
+	return func(opts *options) {
+		opts.StartPage = page
+	}
+}
+
+// WithProgress callback called after every exported page.
+func WithProgress(fn func(progress Progress)) Option {
+	return func(opts *options) {
+		opts.Progress = fn
+	}
+}
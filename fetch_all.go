@@ -0,0 +1,167 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+const (
+	defaultFetchConcurrency = 1
+)
+
+type fetchOptions struct {
+	Concurrency int
+}
+
+// FetchOption specify option for FetchAll and PagesByNumber.
+type FetchOption func(opts *fetchOptions)
+
+// WithConcurrency number of pages fetched in parallel by FetchAll (default 1)
+// or page ranges fetched in parallel by PagesByNumber (default 4).
+func WithConcurrency(concurrency int) FetchOption {
+	return func(opts *fetchOptions) {
+		opts.Concurrency = max(concurrency, 1)
+	}
+}
+
+// fetchResult holds single page fetched by FetchAll worker.
+type fetchResult[T any] struct {
+	Number int
+	Page   *Page[T]
+	Err    error
+}
+
+// FetchAll returns iterator over all pages fetched in parallel and delivered in page order.
+// count is requested once, at most concurrency pages are fetched at the same time and
+// fetched pages are not buffered beyond concurrency until consumed.
+// iteration stops on the first error cancelling remaining fetches, when context is cancelled or when loop breaks.
+// paginators without exact count (WithoutCount, EstimatingQueryer) don't call Count
+// and fetch pages sequentially as Pages does.
+func (p *Paginator[T]) FetchAll(ctx context.Context, opts ...FetchOption) iter.Seq2[*Page[T], error] {
+	defaultOptions := fetchOptions{
+		Concurrency: defaultFetchConcurrency,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	if !p.exactCount() {
+		return p.Pages(ctx)
+	}
+
+	return func(yield func(*Page[T], error) bool) {
+		count, err := p.count(ctx)
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		pageTotalCount, _ := calculatePageCountAndLastPageSize(count, p.pageSize)
+
+		var (
+			fetchCtx, cancel = context.WithCancelCause(ctx)
+			wg               sync.WaitGroup
+			pending          = p.fetchPages(fetchCtx, &wg, count, pageTotalCount, defaultOptions.Concurrency)
+		)
+
+		defer func() {
+			cancel(nil)
+			wg.Wait()
+		}()
+
+		delivered := 0
+
+		for results := range pending {
+			res := <-results
+			if res.Err != nil {
+				cancel(res.Err)
+				yield(nil, fmt.Errorf("page %d: %w", res.Number, res.Err))
+
+				return
+			}
+
+			delivered++
+
+			if !yield(res.Page, nil) {
+				return
+			}
+		}
+
+		if delivered < pageTotalCount {
+			yield(nil, fmt.Errorf("page %d: %w", delivered+1, context.Cause(fetchCtx)))
+		}
+	}
+}
+
+// exactCount reports whether paginator requests exact total count.
+func (p *Paginator[T]) exactCount() bool {
+	if p.opts.WithoutCount {
+		return false
+	}
+
+	_, ok := p.queryer.(EstimatingQueryer)
+
+	return !ok
+}
+
+// fetchPages starts workers fetching pages in order and returns their result channels in the same order.
+// pending channel capacity limits pages fetched ahead of consumer.
+func (p *Paginator[T]) fetchPages(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	count int,
+	pageTotalCount int,
+	concurrency int,
+) <-chan chan fetchResult[T] {
+	var (
+		pending   = make(chan chan fetchResult[T], concurrency)
+		semaphore = make(chan struct{}, concurrency)
+	)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		defer close(pending)
+
+		for number := 1; number <= pageTotalCount; number++ {
+			results := make(chan fetchResult[T], 1)
+
+			select {
+			case <-ctx.Done():
+				return
+			case semaphore <- struct{}{}:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case pending <- results:
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				page, err := p.pageByCount(ctx, pageRequest{
+					Number: number,
+					Size:   p.pageSize,
+				}, count)
+
+				results <- fetchResult[T]{
+					Number: number,
+					Page:   page,
+					Err:    err,
+				}
+			}()
+		}
+	}()
+
+	return pending
+}
@@ -0,0 +1,61 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// Pages returns iterator over all pages starting from the first one.
+// iteration stops after the last page, on the first error, when context is cancelled or when loop breaks.
+func (p *Paginator[T]) Pages(ctx context.Context) iter.Seq2[*Page[T], error] {
+	return func(yield func(*Page[T], error) bool) {
+		for pageNumber := 1; ; pageNumber++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, fmt.Errorf("page %d: %w", pageNumber, err))
+
+				return
+			}
+
+			page, err := p.Page(ctx, pageNumber)
+			if err != nil {
+				yield(nil, fmt.Errorf("page %d: %w", pageNumber, err))
+
+				return
+			}
+
+			// consistent empty page means empty collection or page past the end,
+			// inconsistent one (rows deleted between Count and Query calls) is yielded
+			// and iteration goes on while the page reports next page.
+			if page.IsEmpty() && !page.Inconsistent {
+				return
+			}
+
+			if !yield(page, nil) || !page.HasNext() {
+				return
+			}
+		}
+	}
+}
+
+// Items returns iterator over all items of all pages.
+// iteration stops on the first error, when context is cancelled or when loop breaks.
+func (p *Paginator[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range p.Pages(ctx) {
+			if err != nil {
+				var defaultVal T
+
+				yield(defaultVal, err)
+
+				return
+			}
+
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
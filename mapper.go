@@ -0,0 +1,86 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+)
+
+// MapPage returns page with data converted by convert func.
+// page metadata is preserved.
+func MapPage[T any, U any](page *Page[T], convert func(T) (U, error)) (*Page[U], error) {
+	data, err := mapData(page.Data, convert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Page[U]{
+		Data:              data,
+		Offset:            page.Offset,
+		BottomIndex:       page.BottomIndex,
+		TopIndex:          page.TopIndex,
+		PageSize:          page.PageSize,
+		PageNumber:        page.PageNumber,
+		PageTotalCount:    page.PageTotalCount,
+		ItemTotalCount:    page.ItemTotalCount,
+		TotalUnknown:      page.TotalUnknown,
+		TotalIsEstimate:   page.TotalIsEstimate,
+		Inconsistent:      page.Inconsistent,
+		NextPageToken:     page.NextPageToken,
+		PreviousPageToken: page.PreviousPageToken,
+	}, nil
+}
+
+// MapQueryer adapts Queryer[T] to Queryer[U] converting query data.
+// optional queryer interfaces of underlying queryer are not forwarded.
+type MapQueryer[T any, U any] struct {
+	queryer Queryer[T]
+	convert func(T) (U, error)
+}
+
+// NewMapQueryer constructs new MapQueryer.
+func NewMapQueryer[T any, U any](queryer Queryer[T], convert func(T) (U, error)) *MapQueryer[T, U] {
+	return &MapQueryer[T, U]{
+		queryer: queryer,
+		convert: convert,
+	}
+}
+
+// Query returns underlying queryer data converted by convert func.
+func (m *MapQueryer[T, U]) Query(ctx context.Context, offset int, limit int) ([]U, error) {
+	data, err := m.queryer.Query(ctx, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	return mapData(data, m.convert)
+}
+
+// Count returns underlying queryer count.
+func (m *MapQueryer[T, U]) Count(ctx context.Context) (int, error) {
+	count, err := m.queryer.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count: %w", err)
+	}
+
+	return count, nil
+}
+
+// mapData converts data items keeping nil slice as nil.
+func mapData[T any, U any](data []T, convert func(T) (U, error)) ([]U, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	converted := make([]U, 0, len(data))
+
+	for i, item := range data {
+		convertedItem, err := convert(item)
+		if err != nil {
+			return nil, fmt.Errorf("convert item %d: %w", i, err)
+		}
+
+		converted = append(converted, convertedItem)
+	}
+
+	return converted, nil
+}
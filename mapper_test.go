@@ -0,0 +1,69 @@
+package paginator_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+func itoa(item int) (string, error) {
+	return strconv.Itoa(item), nil
+}
+
+func TestMapPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 3)
+
+	require.NoError(t, err)
+
+	mapped, err := paginator.MapPage(page, itoa)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"21", "22", "23", "24", "25"}, mapped.Data)
+	require.Equal(t, page.Offset, mapped.Offset)
+	require.Equal(t, page.BottomIndex, mapped.BottomIndex)
+	require.Equal(t, page.TopIndex, mapped.TopIndex)
+	require.Equal(t, page.PageSize, mapped.PageSize)
+	require.Equal(t, page.PageNumber, mapped.PageNumber)
+	require.Equal(t, page.PageTotalCount, mapped.PageTotalCount)
+	require.Equal(t, page.ItemTotalCount, mapped.ItemTotalCount)
+}
+
+func TestMapPageError(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	mapped, err := paginator.MapPage(page, func(item int) (string, error) {
+		if item == 5 {
+			return "", errors.New("some convert error")
+		}
+
+		return strconv.Itoa(item), nil
+	})
+
+	require.EqualError(t, err, "convert item 4: some convert error")
+	require.Nil(t, mapped)
+}
+
+func TestMapQueryer(t *testing.T) {
+	t.Parallel()
+
+	pag := paginator.New(paginator.NewMapQueryer(queryerslice.New([]int{1, 2, 3, 4, 5}), itoa), 2)
+
+	page, err := pag.Page(t.Context(), 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"5"}, page.Data)
+	require.Equal(t, 3, page.PageTotalCount)
+	require.Equal(t, 5, page.ItemTotalCount)
+}
@@ -69,3 +69,274 @@ func (mr *MockQueryerMockRecorder[T]) Query(ctx, offset, limit any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockQueryer[T])(nil).Query), ctx, offset, limit)
 }
+
+// MockQueryCounter is a mock of QueryCounter interface.
+type MockQueryCounter[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryCounterMockRecorder[T]
+	isgomock struct{}
+}
+
+// MockQueryCounterMockRecorder is the mock recorder for MockQueryCounter.
+type MockQueryCounterMockRecorder[T any] struct {
+	mock *MockQueryCounter[T]
+}
+
+// NewMockQueryCounter creates a new mock instance.
+func NewMockQueryCounter[T any](ctrl *gomock.Controller) *MockQueryCounter[T] {
+	mock := &MockQueryCounter[T]{ctrl: ctrl}
+	mock.recorder = &MockQueryCounterMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryCounter[T]) EXPECT() *MockQueryCounterMockRecorder[T] {
+	return m.recorder
+}
+
+// QueryCount mocks base method.
+func (m *MockQueryCounter[T]) QueryCount(ctx context.Context, offset, limit int) ([]T, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCount", ctx, offset, limit)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryCount indicates an expected call of QueryCount.
+func (mr *MockQueryCounterMockRecorder[T]) QueryCount(ctx, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCount", reflect.TypeOf((*MockQueryCounter[T])(nil).QueryCount), ctx, offset, limit)
+}
+
+// MockEstimatingQueryer is a mock of EstimatingQueryer interface.
+type MockEstimatingQueryer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEstimatingQueryerMockRecorder
+	isgomock struct{}
+}
+
+// MockEstimatingQueryerMockRecorder is the mock recorder for MockEstimatingQueryer.
+type MockEstimatingQueryerMockRecorder struct {
+	mock *MockEstimatingQueryer
+}
+
+// NewMockEstimatingQueryer creates a new mock instance.
+func NewMockEstimatingQueryer(ctrl *gomock.Controller) *MockEstimatingQueryer {
+	mock := &MockEstimatingQueryer{ctrl: ctrl}
+	mock.recorder = &MockEstimatingQueryerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEstimatingQueryer) EXPECT() *MockEstimatingQueryerMockRecorder {
+	return m.recorder
+}
+
+// EstimateCount mocks base method.
+func (m *MockEstimatingQueryer) EstimateCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateCount", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateCount indicates an expected call of EstimateCount.
+func (mr *MockEstimatingQueryerMockRecorder) EstimateCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCount", reflect.TypeOf((*MockEstimatingQueryer)(nil).EstimateCount), ctx)
+}
+
+// MockPositionQueryer is a mock of PositionQueryer interface.
+type MockPositionQueryer[T any, K any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionQueryerMockRecorder[T, K]
+	isgomock struct{}
+}
+
+// MockPositionQueryerMockRecorder is the mock recorder for MockPositionQueryer.
+type MockPositionQueryerMockRecorder[T any, K any] struct {
+	mock *MockPositionQueryer[T, K]
+}
+
+// NewMockPositionQueryer creates a new mock instance.
+func NewMockPositionQueryer[T any, K any](ctrl *gomock.Controller) *MockPositionQueryer[T, K] {
+	mock := &MockPositionQueryer[T, K]{ctrl: ctrl}
+	mock.recorder = &MockPositionQueryerMockRecorder[T, K]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionQueryer[T, K]) EXPECT() *MockPositionQueryerMockRecorder[T, K] {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockPositionQueryer[T, K]) Count(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockPositionQueryerMockRecorder[T, K]) Count(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockPositionQueryer[T, K])(nil).Count), ctx)
+}
+
+// Position mocks base method.
+func (m *MockPositionQueryer[T, K]) Position(ctx context.Context, key K) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Position", ctx, key)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Position indicates an expected call of Position.
+func (mr *MockPositionQueryerMockRecorder[T, K]) Position(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Position", reflect.TypeOf((*MockPositionQueryer[T, K])(nil).Position), ctx, key)
+}
+
+// Query mocks base method.
+func (m *MockPositionQueryer[T, K]) Query(ctx context.Context, offset, limit int) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", ctx, offset, limit)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockPositionQueryerMockRecorder[T, K]) Query(ctx, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockPositionQueryer[T, K])(nil).Query), ctx, offset, limit)
+}
+
+// MockCursorQueryer is a mock of CursorQueryer interface.
+type MockCursorQueryer[T any, K any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockCursorQueryerMockRecorder[T, K]
+	isgomock struct{}
+}
+
+// MockCursorQueryerMockRecorder is the mock recorder for MockCursorQueryer.
+type MockCursorQueryerMockRecorder[T any, K any] struct {
+	mock *MockCursorQueryer[T, K]
+}
+
+// NewMockCursorQueryer creates a new mock instance.
+func NewMockCursorQueryer[T any, K any](ctrl *gomock.Controller) *MockCursorQueryer[T, K] {
+	mock := &MockCursorQueryer[T, K]{ctrl: ctrl}
+	mock.recorder = &MockCursorQueryerMockRecorder[T, K]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCursorQueryer[T, K]) EXPECT() *MockCursorQueryerMockRecorder[T, K] {
+	return m.recorder
+}
+
+// Key mocks base method.
+func (m *MockCursorQueryer[T, K]) Key(item T) K {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", item)
+	ret0, _ := ret[0].(K)
+	return ret0
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockCursorQueryerMockRecorder[T, K]) Key(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockCursorQueryer[T, K])(nil).Key), item)
+}
+
+// QueryAfter mocks base method.
+func (m *MockCursorQueryer[T, K]) QueryAfter(ctx context.Context, key *K, limit int) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAfter", ctx, key, limit)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAfter indicates an expected call of QueryAfter.
+func (mr *MockCursorQueryerMockRecorder[T, K]) QueryAfter(ctx, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAfter", reflect.TypeOf((*MockCursorQueryer[T, K])(nil).QueryAfter), ctx, key, limit)
+}
+
+// QueryBefore mocks base method.
+func (m *MockCursorQueryer[T, K]) QueryBefore(ctx context.Context, key K, limit int) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBefore", ctx, key, limit)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBefore indicates an expected call of QueryBefore.
+func (mr *MockCursorQueryerMockRecorder[T, K]) QueryBefore(ctx, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBefore", reflect.TypeOf((*MockCursorQueryer[T, K])(nil).QueryBefore), ctx, key, limit)
+}
+
+// MockParamQueryer is a mock of ParamQueryer interface.
+type MockParamQueryer[T any, P any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockParamQueryerMockRecorder[T, P]
+	isgomock struct{}
+}
+
+// MockParamQueryerMockRecorder is the mock recorder for MockParamQueryer.
+type MockParamQueryerMockRecorder[T any, P any] struct {
+	mock *MockParamQueryer[T, P]
+}
+
+// NewMockParamQueryer creates a new mock instance.
+func NewMockParamQueryer[T any, P any](ctrl *gomock.Controller) *MockParamQueryer[T, P] {
+	mock := &MockParamQueryer[T, P]{ctrl: ctrl}
+	mock.recorder = &MockParamQueryerMockRecorder[T, P]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParamQueryer[T, P]) EXPECT() *MockParamQueryerMockRecorder[T, P] {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockParamQueryer[T, P]) Count(ctx context.Context, params P) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, params)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockParamQueryerMockRecorder[T, P]) Count(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockParamQueryer[T, P])(nil).Count), ctx, params)
+}
+
+// Query mocks base method.
+func (m *MockParamQueryer[T, P]) Query(ctx context.Context, params P, offset, limit int) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", ctx, params, offset, limit)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockParamQueryerMockRecorder[T, P]) Query(ctx, params, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockParamQueryer[T, P])(nil).Query), ctx, params, offset, limit)
+}
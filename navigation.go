@@ -0,0 +1,136 @@
+package paginator
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	defaultNavigationEdgeCount        = 1
+	defaultNavigationSurroundingCount = 2
+)
+
+// NavigationItem represents single element of page navigation.
+// Ellipsis item marks skipped pages and has zero Number.
+type NavigationItem struct {
+	Number   int
+	Ellipsis bool
+	Current  bool
+	First    bool
+	Last     bool
+}
+
+type navigationOptions struct {
+	EdgeCount        int
+	SurroundingCount int
+}
+
+// NavigationOption specify option for Navigation.
+type NavigationOption func(opts *navigationOptions)
+
+// WithEdgeCount number of pages always shown at the beginning and at the end (default 1).
+func WithEdgeCount(count int) NavigationOption {
+	return func(opts *navigationOptions) {
+		opts.EdgeCount = count
+	}
+}
+
+// WithSurroundingCount number of pages shown on each side of the current page (default 2).
+func WithSurroundingCount(count int) NavigationOption {
+	return func(opts *navigationOptions) {
+		opts.SurroundingCount = count
+	}
+}
+
+// Navigation returns ordered navigation items for the current page and total pages count
+// e.g. "1 … 4 5 [6] 7 8 … 20" with default options.
+// single skipped page is shown instead of ellipsis.
+func Navigation(current int, total int, opts ...NavigationOption) []NavigationItem {
+	defaultOptions := navigationOptions{
+		EdgeCount:        defaultNavigationEdgeCount,
+		SurroundingCount: defaultNavigationSurroundingCount,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	var (
+		edgeCount        = max(defaultOptions.EdgeCount, 0)
+		surroundingCount = max(defaultOptions.SurroundingCount, 0)
+		items            = make([]NavigationItem, 0, edgeCount*2+surroundingCount*2+3) //nolint:mnd
+		lastNumber       = 0
+	)
+
+	for _, pageRange := range mergeNavigationRanges([][2]int{
+		{1, edgeCount},
+		{current - surroundingCount, current + surroundingCount},
+		{total - edgeCount + 1, total},
+	}, total) {
+		switch pageRange[0] - lastNumber {
+		case 1:
+		case 2: //nolint:mnd
+			items = append(items, makeNavigationItem(lastNumber+1, current, total))
+		default:
+			items = append(items, NavigationItem{Ellipsis: true})
+		}
+
+		for number := pageRange[0]; number <= pageRange[1]; number++ {
+			items = append(items, makeNavigationItem(number, current, total))
+		}
+
+		lastNumber = pageRange[1]
+	}
+
+	return items
+}
+
+// Navigation returns navigation items for the page.
+// next page is treated as the last one if total is unknown.
+func (p *Page[T]) Navigation(opts ...NavigationOption) []NavigationItem {
+	total := p.PageTotalCount
+	if p.TotalUnknown {
+		total = p.PageNumber + 1
+	}
+
+	return Navigation(p.PageNumber, total, opts...)
+}
+
+func makeNavigationItem(number int, current int, total int) NavigationItem {
+	return NavigationItem{
+		Number:  number,
+		Current: number == current,
+		First:   number == 1,
+		Last:    number == total,
+	}
+}
+
+// mergeNavigationRanges clamps ranges to [1, total], sorts them and merges overlapping or adjacent ones.
+func mergeNavigationRanges(ranges [][2]int, total int) [][2]int {
+	for i := range ranges {
+		ranges[i][0] = max(ranges[i][0], 1)
+		ranges[i][1] = min(ranges[i][1], total)
+	}
+
+	slices.SortFunc(ranges, func(a, b [2]int) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	merged := make([][2]int, 0, len(ranges))
+
+	for _, pageRange := range ranges {
+		if pageRange[0] > pageRange[1] {
+			continue
+		}
+
+		if len(merged) > 0 && pageRange[0] <= merged[len(merged)-1][1]+1 {
+			merged[len(merged)-1][1] = max(merged[len(merged)-1][1], pageRange[1])
+
+			continue
+		}
+
+		merged = append(merged, pageRange)
+	}
+
+	return merged
+}
@@ -0,0 +1,87 @@
+package paginator_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+func formatNavigation(items []paginator.NavigationItem) string {
+	parts := make([]string, 0, len(items))
+
+	for _, item := range items {
+		switch {
+		case item.Ellipsis:
+			parts = append(parts, "…")
+		case item.Current:
+			parts = append(parts, "["+strconv.Itoa(item.Number)+"]")
+		default:
+			parts = append(parts, strconv.Itoa(item.Number))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func TestNavigation(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		current  int
+		total    int
+		opts     []paginator.NavigationOption
+		expected string
+	}{
+		"middle":          {current: 6, total: 20, expected: "1 … 4 5 [6] 7 8 … 20"},
+		"first":           {current: 1, total: 20, expected: "[1] 2 3 … 20"},
+		"last":            {current: 20, total: 20, expected: "1 … 18 19 [20]"},
+		"single gap page": {current: 5, total: 20, expected: "1 2 3 4 [5] 6 7 … 20"},
+		"small total":     {current: 2, total: 5, expected: "1 [2] 3 4 5"},
+		"single page":     {current: 1, total: 1, expected: "[1]"},
+		"empty":           {current: 1, total: 0, expected: ""},
+		"past the end":    {current: 15, total: 11, expected: "1 … 11"},
+		"custom counts": {
+			current:  10,
+			total:    20,
+			opts:     []paginator.NavigationOption{paginator.WithEdgeCount(2), paginator.WithSurroundingCount(1)},
+			expected: "1 2 … 9 [10] 11 … 19 20",
+		},
+		"wide edges": {
+			current:  20,
+			total:    20,
+			opts:     []paginator.NavigationOption{paginator.WithEdgeCount(5), paginator.WithSurroundingCount(1)},
+			expected: "1 2 3 4 5 … 16 17 18 19 [20]",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, formatNavigation(paginator.Navigation(tc.current, tc.total, tc.opts...)))
+		})
+	}
+}
+
+func TestNavigationFlags(t *testing.T) {
+	t.Parallel()
+
+	items := paginator.Navigation(1, 3)
+
+	require.Equal(t, []paginator.NavigationItem{
+		{Number: 1, Current: true, First: true},
+		{Number: 2},
+		{Number: 3, Last: true},
+	}, items)
+}
+
+func TestPageNavigation(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(101, 10).Page(t.Context(), 6)
+
+	require.NoError(t, err)
+	require.Equal(t, "1 … 4 5 [6] 7 8 … 11", formatNavigation(page.Navigation()))
+}
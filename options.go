@@ -0,0 +1,161 @@
+package paginator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+// OutOfRangePolicy specifies Paginator behaviour for page number past the last page.
+type OutOfRangePolicy int
+
+const (
+	// OutOfRangeError returns PageRangeError (default).
+	OutOfRangeError OutOfRangePolicy = iota
+	// OutOfRangeClamp returns the last page instead.
+	OutOfRangeClamp
+	// OutOfRangeEmpty returns empty page with requested page number and actual page total count.
+	OutOfRangeEmpty
+)
+
+const (
+	defaultPageSize = 10
+)
+
+type options struct {
+	PageSize         int
+	MinPageSize      int
+	MaxPageSize      int
+	IndexBase        int
+	WithoutCount     bool
+	ConcurrentQuery  bool
+	DriftRetries     int
+	PrefetchLimit    int
+	PrefetchTTL      time.Duration
+	PrefetchHook     func(page int, used bool)
+	OutOfRangePolicy OutOfRangePolicy
+	TokenCodec       *token.Codec
+}
+
+func (o *options) validate() error {
+	if o.MinPageSize <= 0 {
+		return fmt.Errorf("%w: min page size %d", ErrInvalidOptions, o.MinPageSize)
+	}
+
+	if o.MaxPageSize != 0 && o.MaxPageSize < o.MinPageSize {
+		return fmt.Errorf("%w: max page size %d less than min page size %d",
+			ErrInvalidOptions, o.MaxPageSize, o.MinPageSize)
+	}
+
+	if o.PageSize < o.MinPageSize || (o.MaxPageSize != 0 && o.PageSize > o.MaxPageSize) {
+		return fmt.Errorf("%w: page size %d out of bounds [%d, %d]",
+			ErrInvalidOptions, o.PageSize, o.MinPageSize, o.MaxPageSize)
+	}
+
+	if o.DriftRetries < 0 {
+		return fmt.Errorf("%w: drift retries %d", ErrInvalidOptions, o.DriftRetries)
+	}
+
+	if o.PrefetchLimit < 0 || (o.PrefetchLimit > 0 && o.PrefetchTTL <= 0) {
+		return fmt.Errorf("%w: prefetch limit %d ttl %s", ErrInvalidOptions, o.PrefetchLimit, o.PrefetchTTL)
+	}
+
+	if o.IndexBase != 0 && o.IndexBase != 1 {
+		return fmt.Errorf("%w: index base %d", ErrInvalidOptions, o.IndexBase)
+	}
+
+	switch o.OutOfRangePolicy {
+	case OutOfRangeError, OutOfRangeClamp, OutOfRangeEmpty:
+	default:
+		return fmt.Errorf("%w: out of range policy %d", ErrInvalidOptions, o.OutOfRangePolicy)
+	}
+
+	return nil
+}
+
+// Option specify option for Paginator.
+type Option func(opts *options)
+
+// WithPageSize default page size (default 10).
+func WithPageSize(size int) Option {
+	return func(opts *options) {
+		opts.PageSize = size
+	}
+}
+
+// WithPageSizeBounds min and max page size (default 1 and unlimited).
+// zero max means unlimited page size.
+func WithPageSizeBounds(minSize int, maxSize int) Option {
+	return func(opts *options) {
+		opts.MinPageSize = minSize
+		opts.MaxPageSize = maxSize
+	}
+}
+
+// WithIndexBase base for page BottomIndex and TopIndex, 0 or 1 (default 1).
+func WithIndexBase(base int) Option {
+	return func(opts *options) {
+		opts.IndexBase = base
+	}
+}
+
+// WithoutCount disables Queryer.Count calls.
+// Page requests one extra item to find out whether next page is available
+// and reports total as unknown until the last page is reached.
+func WithoutCount() Option {
+	return func(opts *options) {
+		opts.WithoutCount = true
+	}
+}
+
+// WithConcurrentQuery runs Queryer.Count and Queryer.Query concurrently.
+// data is requested with the full page size and trimmed to the last page size after count is fetched,
+// the sibling call is cancelled on error.
+func WithConcurrentQuery() Option {
+	return func(opts *options) {
+		opts.ConcurrentQuery = true
+	}
+}
+
+// WithDriftRetries number of page refetches with fresh count
+// when query data doesn't match the count (default 0).
+// page is returned with Inconsistent flag if retries are exhausted.
+// retries have no effect if count is cached.
+func WithDriftRetries(retries int) Option {
+	return func(opts *options) {
+		opts.DriftRetries = retries
+	}
+}
+
+// WithPrefetch enables background fetch of the next page data after page is served (disabled by default).
+// limit bounds prefetched pages held at the same time (in flight or ready),
+// prefetch query is cancelled and unused data is dropped after ttl.
+// works for default Count then Query mode only and is not used by ParamPaginator.
+func WithPrefetch(limit int, ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.PrefetchLimit = limit
+		opts.PrefetchTTL = ttl
+	}
+}
+
+// WithPrefetchHook callback reporting whether prefetched page was used or wasted.
+func WithPrefetchHook(hook func(page int, used bool)) Option {
+	return func(opts *options) {
+		opts.PrefetchHook = hook
+	}
+}
+
+// WithOutOfRangePolicy specify behaviour for page number past the last page (default OutOfRangeError).
+func WithOutOfRangePolicy(policy OutOfRangePolicy) Option {
+	return func(opts *options) {
+		opts.OutOfRangePolicy = policy
+	}
+}
+
+// WithTokenCodec codec for PageByToken page tokens.
+func WithTokenCodec(codec *token.Codec) Option {
+	return func(opts *options) {
+		opts.TokenCodec = codec
+	}
+}
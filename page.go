@@ -1,17 +1,53 @@
 package paginator
 
 // Page represents single page information.
-type Page[T any] struct {
-	Data           []T
-	BottomIndex    int
-	TopIndex       int
-	PageSize       int
-	PageNumber     int
-	PageTotalCount int
+// Offset is the number of items before the page, BottomIndex and TopIndex are
+// indexes of the first and the last page items (zero for empty page).
+// ItemTotalCount and PageTotalCount are total items and total pages in collection.
+// Empty collection has the single empty first page with zero totals.
+// TotalUnknown is set when page is requested without count and
+// the last page is not reached yet, totals are zero in this case.
+// TotalIsEstimate is set when totals are calculated from estimated total count.
+// Inconsistent is set when data length doesn't match PageSize calculated from total count
+// (e.g. rows were deleted between Count and Query calls).
+// NextPageToken and PreviousPageToken are set by PageByToken for available pages.
+type Page[T any] struct { //nolint:recvcheck
+	Data              []T
+	Offset            int
+	BottomIndex       int
+	TopIndex          int
+	PageSize          int
+	PageNumber        int
+	PageTotalCount    int
+	ItemTotalCount    int
+	TotalUnknown      bool
+	TotalIsEstimate   bool
+	Inconsistent      bool
+	NextPageToken     string
+	PreviousPageToken string
+}
+
+// IsEmpty returns true if page has no data.
+func (p *Page[T]) IsEmpty() bool {
+	return len(p.Data) == 0
+}
+
+// IsFirst returns true for the first page.
+func (p *Page[T]) IsFirst() bool {
+	return !p.HasPrevious()
+}
+
+// IsLast returns true if there are no pages after this one.
+func (p *Page[T]) IsLast() bool {
+	return !p.HasNext()
 }
 
 // HasNext returns true if next page is available.
 func (p *Page[T]) HasNext() bool {
+	if p.TotalUnknown {
+		return true
+	}
+
 	return p.PageNumber < p.PageTotalCount
 }
 
@@ -30,10 +66,15 @@ func (p *Page[T]) HasPrevious() bool {
 }
 
 // Previous returns previous page number.
+// returns the last page number for empty page past the last page.
 func (p *Page[T]) Previous() int {
-	if p.HasPrevious() {
-		return p.PageNumber - 1
+	if !p.HasPrevious() {
+		return 1
+	}
+
+	if !p.TotalUnknown && p.PageNumber > p.PageTotalCount {
+		return max(p.PageTotalCount, 1)
 	}
 
-	return 1
+	return p.PageNumber - 1
 }
@@ -0,0 +1,243 @@
+package paginator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// EnvelopeFields specifies JSON field names of page envelope.
+// Data and Pagination are top level fields, others are nested into Pagination object.
+type EnvelopeFields struct {
+	Data              string
+	Pagination        string
+	Page              string
+	PageSize          string
+	TotalPages        string
+	TotalItems        string
+	Offset            string
+	BottomIndex       string
+	TopIndex          string
+	TotalUnknown      string
+	TotalIsEstimate   string
+	Inconsistent      string
+	NextPageToken     string
+	PreviousPageToken string
+}
+
+// DefaultEnvelopeFields returns field names used by Page JSON encoding:
+// {"data":[...],"pagination":{"page":..,"pageSize":..,"totalPages":..,"totalItems":..,...}}.
+func DefaultEnvelopeFields() EnvelopeFields {
+	return EnvelopeFields{
+		Data:              "data",
+		Pagination:        "pagination",
+		Page:              "page",
+		PageSize:          "pageSize",
+		TotalPages:        "totalPages",
+		TotalItems:        "totalItems",
+		Offset:            "offset",
+		BottomIndex:       "bottomIndex",
+		TopIndex:          "topIndex",
+		TotalUnknown:      "totalUnknown",
+		TotalIsEstimate:   "totalIsEstimate",
+		Inconsistent:      "inconsistent",
+		NextPageToken:     "nextPageToken",
+		PreviousPageToken: "previousPageToken",
+	}
+}
+
+// Envelope wraps page for JSON encoding with custom field names.
+// empty field names are taken from DefaultEnvelopeFields.
+type Envelope[T any] struct {
+	Page   *Page[T]
+	Fields EnvelopeFields
+}
+
+// NewEnvelope constructs new Envelope.
+func NewEnvelope[T any](page *Page[T], fields EnvelopeFields) *Envelope[T] {
+	return &Envelope[T]{
+		Page:   page,
+		Fields: fields,
+	}
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// nil page is encoded as null.
+func (e *Envelope[T]) MarshalJSON() ([]byte, error) {
+	if e.Page == nil {
+		return []byte("null"), nil
+	}
+
+	var (
+		fields = e.fields()
+		data   = e.Page.Data
+	)
+
+	if data == nil {
+		data = []T{}
+	}
+
+	pagination, err := marshalEnvelopeObject(e.paginationFields())
+	if err != nil {
+		return nil, fmt.Errorf("marshal pagination: %w", err)
+	}
+
+	return marshalEnvelopeObject([]envelopeField{
+		{Name: fields.Data, Value: data},
+		{Name: fields.Pagination, Value: json.RawMessage(pagination)},
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// missing fields are left zero.
+func (e *Envelope[T]) UnmarshalJSON(data []byte) error {
+	if e.Page == nil {
+		e.Page = &Page[T]{}
+	}
+
+	fields := e.fields()
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("unmarshal envelope: %w", err)
+	}
+
+	if err := unmarshalEnvelopeObject(envelope, []envelopeField{
+		{Name: fields.Data, Value: &e.Page.Data},
+	}); err != nil {
+		return fmt.Errorf("unmarshal data: %w", err)
+	}
+
+	rawPagination, ok := envelope[fields.Pagination]
+	if !ok {
+		return nil
+	}
+
+	var pagination map[string]json.RawMessage
+	if err := json.Unmarshal(rawPagination, &pagination); err != nil {
+		return fmt.Errorf("unmarshal pagination: %w", err)
+	}
+
+	if err := unmarshalEnvelopeObject(pagination, e.paginationFields()); err != nil {
+		return fmt.Errorf("unmarshal pagination fields: %w", err)
+	}
+
+	return nil
+}
+
+// fields returns envelope field names with empty names replaced by defaults.
+func (e *Envelope[T]) fields() EnvelopeFields {
+	var (
+		fields   = e.Fields
+		defaults = DefaultEnvelopeFields()
+	)
+
+	for _, field := range []struct {
+		Name    *string
+		Default string
+	}{
+		{Name: &fields.Data, Default: defaults.Data},
+		{Name: &fields.Pagination, Default: defaults.Pagination},
+		{Name: &fields.Page, Default: defaults.Page},
+		{Name: &fields.PageSize, Default: defaults.PageSize},
+		{Name: &fields.TotalPages, Default: defaults.TotalPages},
+		{Name: &fields.TotalItems, Default: defaults.TotalItems},
+		{Name: &fields.Offset, Default: defaults.Offset},
+		{Name: &fields.BottomIndex, Default: defaults.BottomIndex},
+		{Name: &fields.TopIndex, Default: defaults.TopIndex},
+		{Name: &fields.TotalUnknown, Default: defaults.TotalUnknown},
+		{Name: &fields.TotalIsEstimate, Default: defaults.TotalIsEstimate},
+		{Name: &fields.Inconsistent, Default: defaults.Inconsistent},
+		{Name: &fields.NextPageToken, Default: defaults.NextPageToken},
+		{Name: &fields.PreviousPageToken, Default: defaults.PreviousPageToken},
+	} {
+		if *field.Name == "" {
+			*field.Name = field.Default
+		}
+	}
+
+	return fields
+}
+
+// paginationFields returns pagination field names with pointers to page values.
+func (e *Envelope[T]) paginationFields() []envelopeField {
+	fields := e.fields()
+
+	return []envelopeField{
+		{Name: fields.Page, Value: &e.Page.PageNumber},
+		{Name: fields.PageSize, Value: &e.Page.PageSize},
+		{Name: fields.TotalPages, Value: &e.Page.PageTotalCount},
+		{Name: fields.TotalItems, Value: &e.Page.ItemTotalCount},
+		{Name: fields.Offset, Value: &e.Page.Offset},
+		{Name: fields.BottomIndex, Value: &e.Page.BottomIndex},
+		{Name: fields.TopIndex, Value: &e.Page.TopIndex},
+		{Name: fields.TotalUnknown, Value: &e.Page.TotalUnknown},
+		{Name: fields.TotalIsEstimate, Value: &e.Page.TotalIsEstimate},
+		{Name: fields.Inconsistent, Value: &e.Page.Inconsistent},
+		{Name: fields.NextPageToken, Value: &e.Page.NextPageToken},
+		{Name: fields.PreviousPageToken, Value: &e.Page.PreviousPageToken},
+	}
+}
+
+// MarshalJSON implements json.Marshaler interface using DefaultEnvelopeFields.
+// value receiver keeps the same encoding for Page values (e.g. []Page[T]).
+func (p Page[T]) MarshalJSON() ([]byte, error) {
+	return NewEnvelope(&p, DefaultEnvelopeFields()).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface using DefaultEnvelopeFields.
+func (p *Page[T]) UnmarshalJSON(data []byte) error {
+	return NewEnvelope(p, DefaultEnvelopeFields()).UnmarshalJSON(data)
+}
+
+type envelopeField struct {
+	Name  string
+	Value any
+}
+
+// marshalEnvelopeObject marshals fields into JSON object keeping fields order.
+func marshalEnvelopeObject(fields []envelopeField) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, field := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, fmt.Errorf("marshal field name %s: %w", field.Name, err)
+		}
+
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, fmt.Errorf("marshal field %s: %w", field.Name, err)
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalEnvelopeObject unmarshals object values into fields pointers skipping missing fields.
+func unmarshalEnvelopeObject(object map[string]json.RawMessage, fields []envelopeField) error {
+	for _, field := range fields {
+		raw, ok := object[field.Name]
+		if !ok {
+			continue
+		}
+
+		if err := json.Unmarshal(raw, field.Value); err != nil {
+			return fmt.Errorf("unmarshal field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package paginator_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+func TestPageMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 3)
+
+	require.NoError(t, err)
+
+	data, err := json.Marshal(page)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"data": [21, 22, 23, 24, 25],
+		"pagination": {
+			"page": 3,
+			"pageSize": 5,
+			"totalPages": 3,
+			"totalItems": 25,
+			"offset": 20,
+			"bottomIndex": 21,
+			"topIndex": 25,
+			"totalUnknown": false,
+			"totalIsEstimate": false,
+			"inconsistent": false,
+			"nextPageToken": "",
+			"previousPageToken": ""
+		}
+	}`, string(data))
+
+	var decoded paginator.Page[int]
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, page, &decoded)
+}
+
+func TestPageMarshalJSONEmpty(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(0, 10).Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	data, err := json.Marshal(page)
+
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"data":[]`)
+}
+
+func TestPageMarshalJSONValue(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 2)
+
+	require.NoError(t, err)
+
+	expected, err := json.Marshal(page)
+
+	require.NoError(t, err)
+
+	data, err := json.Marshal(*page)
+
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(data))
+
+	data, err = json.Marshal([]paginator.Page[int]{*page})
+
+	require.NoError(t, err)
+	require.JSONEq(t, "["+string(expected)+"]", string(data))
+
+	var decoded []paginator.Page[int]
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, []paginator.Page[int]{*page}, decoded)
+}
+
+func TestEnvelopeNilPage(t *testing.T) {
+	t.Parallel()
+
+	data, err := paginator.NewEnvelope[int](nil, paginator.DefaultEnvelopeFields()).MarshalJSON()
+
+	require.NoError(t, err)
+	require.JSONEq(t, "null", string(data))
+}
+
+func TestEnvelopeCustomFields(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	fields := paginator.DefaultEnvelopeFields()
+	fields.Data = "items"
+	fields.Pagination = "meta"
+	fields.TotalItems = "total"
+
+	data, err := json.Marshal(paginator.NewEnvelope(page, fields))
+
+	require.NoError(t, err)
+
+	var raw struct {
+		Items []int          `json:"items"`
+		Meta  map[string]any `json:"meta"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Len(t, raw.Items, 10)
+	require.InDelta(t, 25, raw.Meta["total"], 0)
+
+	decoded := paginator.NewEnvelope[int](nil, fields)
+
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, page, decoded.Page)
+}
+
+func TestEnvelopePartialFields(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(25, 10).Page(t.Context(), 2)
+
+	require.NoError(t, err)
+
+	fields := paginator.EnvelopeFields{
+		Data:       "items",
+		TotalItems: "total",
+	}
+
+	data, err := json.Marshal(paginator.NewEnvelope(page, fields))
+
+	require.NoError(t, err)
+
+	var raw struct {
+		Items      []int          `json:"items"`
+		Pagination map[string]any `json:"pagination"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Len(t, raw.Items, 10)
+	require.InDelta(t, 25, raw.Pagination["total"], 0)
+	require.InDelta(t, 2, raw.Pagination["page"], 0)
+	require.NotContains(t, raw.Pagination, "")
+	require.NotContains(t, raw.Pagination, "totalItems")
+
+	decoded := paginator.NewEnvelope[int](nil, fields)
+
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.Equal(t, page, decoded.Page)
+}
@@ -0,0 +1,12 @@
+package paginator
+
+// pageRequest specifies requested page number and page size.
+type pageRequest struct {
+	Number int
+	Size   int
+}
+
+// Offset returns offset of the first page item.
+func (r pageRequest) Offset() int {
+	return r.Size * (r.Number - 1)
+}
@@ -0,0 +1,76 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+// PageByToken returns page by opaque token issued by previous PageByToken call.
+// empty token means the first page with default size.
+// filterHash binds tokens to the request filter, token issued for another filter is rejected.
+// returned page contains tokens for the next and the previous pages.
+func (p *Paginator[T]) PageByToken(ctx context.Context, pageToken string, filterHash string) (*Page[T], error) {
+	if p.opts.TokenCodec == nil {
+		return nil, ErrNoTokenCodec
+	}
+
+	req := pageRequest{
+		Number: 1,
+		Size:   p.pageSize,
+	}
+
+	if pageToken != "" {
+		state, err := p.opts.TokenCodec.Decode(pageToken, filterHash)
+		if err != nil {
+			return nil, fmt.Errorf("decode token: %w", err)
+		}
+
+		req = pageRequest{
+			Number: state.Page,
+			Size:   p.boundPageSize(state.Size),
+		}
+	}
+
+	page, err := p.page(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.setPageTokens(page, req.Size, filterHash); err != nil {
+		return nil, fmt.Errorf("set page tokens: %w", err)
+	}
+
+	return page, nil
+}
+
+func (p *Paginator[T]) setPageTokens(page *Page[T], size int, filterHash string) error {
+	if page.HasNext() {
+		nextToken, err := p.opts.TokenCodec.Encode(token.State{
+			Page:       page.Next(),
+			Size:       size,
+			FilterHash: filterHash,
+		})
+		if err != nil {
+			return fmt.Errorf("encode next token: %w", err)
+		}
+
+		page.NextPageToken = nextToken
+	}
+
+	if page.HasPrevious() {
+		previousToken, err := p.opts.TokenCodec.Encode(token.State{
+			Page:       page.Previous(),
+			Size:       size,
+			FilterHash: filterHash,
+		})
+		if err != nil {
+			return fmt.Errorf("encode previous token: %w", err)
+		}
+
+		page.PreviousPageToken = previousToken
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+const (
+	defaultPagesByNumberConcurrency = 4
+)
+
+// PageResult holds page or error for single page of PagesByNumber.
+type PageResult[T any] struct {
+	Page *Page[T]
+	Err  error
+}
+
+// PagesByNumber returns pages by their numbers requesting count once.
+// adjacent pages are fetched with one merged query, separate ranges are fetched concurrently
+// (at most 4 at the same time, see WithConcurrency).
+// page errors (invalid or out of range page, failed query) are returned per page,
+// error is returned only if count fails.
+// paginators without exact count (WithoutCount, EstimatingQueryer) don't call Count
+// and fetch every page separately as Page does, merged queries need the count
+// so QueryCounter and WithConcurrentQuery paginators still request Count once.
+func (p *Paginator[T]) PagesByNumber(
+	ctx context.Context,
+	numbers []int,
+	opts ...FetchOption,
+) (map[int]PageResult[T], error) {
+	defaultOptions := fetchOptions{
+		Concurrency: defaultPagesByNumberConcurrency,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	if !p.exactCount() {
+		return p.pagesByNumberWithoutCount(ctx, numbers, defaultOptions.Concurrency), nil
+	}
+
+	count, err := p.count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results                      = make(map[int]PageResult[T], len(numbers))
+		pageTotalCount, lastPageSize = calculatePageCountAndLastPageSize(count, p.pageSize)
+		inRange                      = make([]int, 0, len(numbers))
+		seen                         = make(map[int]bool, len(numbers))
+	)
+
+	for _, number := range numbers {
+		if seen[number] {
+			continue
+		}
+
+		seen[number] = true
+
+		switch {
+		case number <= 0:
+			results[number] = PageResult[T]{
+				Err: fmt.Errorf("%w: %d", ErrInvalidPage, number),
+			}
+		case count == 0 && number == 1:
+			results[number] = PageResult[T]{
+				Page: emptyPage[T](),
+			}
+		case number > pageTotalCount:
+			page, err := p.outOfRangePage(ctx, pageRequest{
+				Number: number,
+				Size:   p.pageSize,
+			}, count)
+
+			results[number] = PageResult[T]{
+				Page: page,
+				Err:  err,
+			}
+		default:
+			inRange = append(inRange, number)
+		}
+	}
+
+	collectPages(results, adjacentRanges(inRange), defaultOptions.Concurrency,
+		func(pageRange [2]int) map[int]PageResult[T] {
+			return p.pageRange(ctx, pageRange, count, pageTotalCount, lastPageSize)
+		})
+
+	return results, nil
+}
+
+// pagesByNumberWithoutCount fetches every page separately without requesting exact count.
+func (p *Paginator[T]) pagesByNumberWithoutCount(
+	ctx context.Context,
+	numbers []int,
+	concurrency int,
+) map[int]PageResult[T] {
+	var (
+		results = make(map[int]PageResult[T], len(numbers))
+		unique  = slices.Compact(slices.Sorted(slices.Values(numbers)))
+	)
+
+	collectPages(results, unique, concurrency, func(number int) map[int]PageResult[T] {
+		page, err := p.page(ctx, pageRequest{
+			Number: number,
+			Size:   p.pageSize,
+		})
+
+		return map[int]PageResult[T]{
+			number: {
+				Page: page,
+				Err:  err,
+			},
+		}
+	})
+
+	return results
+}
+
+// collectPages runs fetch for every item with at most concurrency calls at the same time
+// and copies fetched pages into results.
+func collectPages[T any, I any](
+	results map[int]PageResult[T],
+	items []I,
+	concurrency int,
+	fetch func(item I) map[int]PageResult[T],
+) {
+	var (
+		wg        sync.WaitGroup
+		mtx       sync.Mutex
+		semaphore = make(chan struct{}, concurrency)
+	)
+
+	for _, item := range items {
+		semaphore <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+
+				wg.Done()
+			}()
+
+			pages := fetch(item)
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			maps.Copy(results, pages)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// pageRange fetches adjacent in range pages with one query and splits data into pages.
+func (p *Paginator[T]) pageRange(
+	ctx context.Context,
+	pageRange [2]int,
+	count int,
+	pageTotalCount int,
+	lastPageSize int,
+) map[int]PageResult[T] {
+	var (
+		first, last = pageRange[0], pageRange[1]
+		results     = make(map[int]PageResult[T], last-first+1)
+		pageLimit   = func(number int) int {
+			if number == pageTotalCount {
+				return lastPageSize
+			}
+
+			return p.pageSize
+		}
+		offset = pageRequest{Number: first, Size: p.pageSize}.Offset()
+		limit  = (last-first)*p.pageSize + pageLimit(last)
+	)
+
+	data, err := p.queryer.Query(ctx, offset, limit)
+	if err != nil {
+		err = &QueryError{
+			Op:  "query data",
+			Err: err,
+		}
+	}
+
+	for number := first; number <= last; number++ {
+		if err != nil {
+			results[number] = PageResult[T]{
+				Err: err,
+			}
+
+			continue
+		}
+
+		var (
+			req   = pageRequest{Number: number, Size: p.pageSize}
+			start = min((number-first)*p.pageSize, len(data))
+			end   = min(start+pageLimit(number), len(data))
+		)
+
+		results[number] = PageResult[T]{
+			Page: makePage(p.makeWindow(data[start:end], req.Offset(), pageLimit(number), count), req),
+		}
+	}
+
+	return results
+}
+
+// adjacentRanges returns sorted ranges of consecutive numbers as [first, last] pairs.
+func adjacentRanges(numbers []int) [][2]int {
+	numbers = slices.Sorted(slices.Values(numbers))
+
+	var ranges [][2]int
+
+	for _, number := range numbers {
+		if len(ranges) > 0 && ranges[len(ranges)-1][1] == number-1 {
+			ranges[len(ranges)-1][1] = number
+
+			continue
+		}
+
+		ranges = append(ranges, [2]int{number, number})
+	}
+
+	return ranges
+}
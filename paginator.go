@@ -3,7 +3,9 @@ package paginator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 )
 
 // Queryer interface for external implementation for paginator usage.
@@ -12,79 +14,512 @@ type Queryer[T any] interface {
 	Count(ctx context.Context) (int, error)
 }
 
+// QueryCounter optional interface for Queryer implementations
+// able to return page data and total count in one call.
+// Paginator uses it instead of separate Count and Query calls when available.
+type QueryCounter[T any] interface {
+	QueryCount(ctx context.Context, offset int, limit int) ([]T, int, error)
+}
+
+// EstimatingQueryer optional interface for Queryer implementations
+// able to return approximate total count cheaper than Count (e.g. from pg_class.reltuples).
+// Paginator uses estimate instead of Count when available.
+type EstimatingQueryer interface {
+	EstimateCount(ctx context.Context) (int, error)
+}
+
+// PositionQueryer optional interface for Queryer implementations
+// able to return zero based position of item with key in Query ordering.
+// found is false if there is no item with key.
+type PositionQueryer[T any, K any] interface {
+	Queryer[T]
+	Position(ctx context.Context, key K) (int, bool, error)
+}
+
+// CursorQueryer interface for external implementation for cursor paginator usage.
+// QueryAfter and QueryBefore should return items in the same order,
+// Key should return value unique for item within that order.
+// QueryAfter with nil key returns items from the beginning.
+type CursorQueryer[T any, K any] interface {
+	QueryAfter(ctx context.Context, key *K, limit int) ([]T, error)
+	QueryBefore(ctx context.Context, key K, limit int) ([]T, error)
+	Key(item T) K
+}
+
+// ParamQueryer interface for external implementation for param paginator usage.
+// params carry per request filters and sort order and are passed to both Query and Count.
+type ParamQueryer[T any, P any] interface {
+	Query(ctx context.Context, params P, offset int, limit int) ([]T, error)
+	Count(ctx context.Context, params P) (int, error)
+}
+
 // Paginator structure.
 type Paginator[T any] struct {
 	queryer  Queryer[T]
 	pageSize int
+	opts     options
+	prefetch *prefetcher[T]
 }
 
 // New construct paginator.
-func New[T any](queryer Queryer[T], pageSize int) *Paginator[T] {
+// panics on invalid options, use NewWithOptions for error handling.
+func New[T any](queryer Queryer[T], pageSize int, opts ...Option) *Paginator[T] {
+	paginator, err := NewWithOptions(queryer, append([]Option{WithPageSize(pageSize)}, opts...)...)
+	if err != nil {
+		panic(err)
+	}
+
+	return paginator
+}
+
+// NewWithOptions construct paginator and validates options.
+// the same options slice can be shared between paginators.
+func NewWithOptions[T any](queryer Queryer[T], opts ...Option) (*Paginator[T], error) {
+	defaultOptions := options{
+		PageSize:    defaultPageSize,
+		MinPageSize: 1,
+		IndexBase:   1,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	if err := defaultOptions.validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %w", err)
+	}
+
+	var prefetch *prefetcher[T]
+	if defaultOptions.PrefetchLimit > 0 {
+		prefetch = newPrefetcher[T](defaultOptions.PrefetchLimit, defaultOptions.PrefetchTTL, defaultOptions.PrefetchHook)
+	}
+
 	return &Paginator[T]{
 		queryer:  queryer,
-		pageSize: pageSize,
-	}
+		pageSize: defaultOptions.PageSize,
+		opts:     defaultOptions,
+		prefetch: prefetch,
+	}, nil
 }
 
 // Page returns information about page by it's number.
 func (p *Paginator[T]) Page(ctx context.Context, page int) (*Page[T], error) {
-	if page <= 0 {
-		return nil, fmt.Errorf("invaid page number: %d", page)
+	return p.page(ctx, pageRequest{
+		Number: page,
+		Size:   p.pageSize,
+	})
+}
+
+// PageWithSize returns information about page by it's number overriding page size.
+// non positive size means default page size, otherwise size is clamped to the configured bounds.
+func (p *Paginator[T]) PageWithSize(ctx context.Context, page int, size int) (*Page[T], error) {
+	return p.page(ctx, pageRequest{
+		Number: page,
+		Size:   p.boundPageSize(size),
+	})
+}
+
+// boundPageSize returns page size clamped to the configured bounds.
+func (p *Paginator[T]) boundPageSize(size int) int {
+	if size <= 0 {
+		return p.pageSize
 	}
 
-	count, err := p.queryer.Count(ctx)
+	if p.opts.MaxPageSize != 0 {
+		size = min(size, p.opts.MaxPageSize)
+	}
+
+	return max(size, p.opts.MinPageSize)
+}
+
+// page returns page and refetches it with fresh count
+// if data doesn't match the count (see WithDriftRetries).
+func (p *Paginator[T]) page(ctx context.Context, req pageRequest) (*Page[T], error) {
+	if req.Number <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPage, req.Number)
+	}
+
+	page, err := p.fetchPage(ctx, req)
+
+	for attempt := 0; err == nil && page.Inconsistent && attempt < p.opts.DriftRetries; attempt++ {
+		page, err = p.fetchPage(ctx, req)
+	}
+
+	if err == nil {
+		p.prefetchNext(ctx, req, page)
+	}
+
+	return page, err
+}
+
+// fetchPage returns page fetched according to count mode applying out of range policy.
+func (p *Paginator[T]) fetchPage(ctx context.Context, req pageRequest) (*Page[T], error) {
+	window, err := p.fetchRange(ctx, req.Offset(), req.Size)
+
+	return p.windowPage(ctx, req, window, err)
+}
+
+// pageByCount returns page for already known total count.
+func (p *Paginator[T]) pageByCount(ctx context.Context, req pageRequest, count int) (*Page[T], error) {
+	window, err := p.rangeByCount(ctx, req.Offset(), req.Size, count)
+
+	return p.windowPage(ctx, req, window, err)
+}
+
+// windowPage converts window fetched for page into page,
+// range error is converted into page according to out of range policy.
+func (p *Paginator[T]) windowPage(
+	ctx context.Context,
+	req pageRequest,
+	window *Window[T],
+	err error,
+) (*Page[T], error) {
+	var rangeErr *RangeError
+	if errors.As(err, &rangeErr) {
+		if rangeErr.TotalUnknown {
+			return nil, &PageRangeError{
+				Page:         req.Number,
+				TotalUnknown: true,
+			}
+		}
+
+		return p.outOfRangePage(ctx, req, rangeErr.ItemTotalCount)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return makePage(window, req), nil
+}
+
+// outOfRangePage applies out of range policy for the page past the last page.
+func (p *Paginator[T]) outOfRangePage(ctx context.Context, req pageRequest, count int) (*Page[T], error) {
+	pageTotalCount, _ := calculatePageCountAndLastPageSize(count, req.Size)
+
+	switch p.opts.OutOfRangePolicy {
+	case OutOfRangeClamp:
+		return p.pageByCount(ctx, pageRequest{
+			Number: max(pageTotalCount, 1),
+			Size:   req.Size,
+		}, count)
+	case OutOfRangeEmpty:
+		return &Page[T]{
+			Offset:         req.Offset(),
+			PageNumber:     req.Number,
+			PageTotalCount: pageTotalCount,
+			ItemTotalCount: count,
+		}, nil
+	case OutOfRangeError:
+	}
+
+	return nil, &PageRangeError{
+		Page:       req.Number,
+		TotalPages: pageTotalCount,
+	}
+}
+
+// fetchRange returns window fetched according to count mode
+// (WithoutCount, EstimatingQueryer, QueryCounter, WithConcurrentQuery or separate Count and Query).
+// offset past the last item is returned as *RangeError, out of range policy is applied by the caller.
+func (p *Paginator[T]) fetchRange(ctx context.Context, offset int, limit int) (*Window[T], error) {
+	if p.opts.WithoutCount {
+		return p.rangeWithoutCount(ctx, offset, limit)
+	}
+
+	if estimator, ok := p.queryer.(EstimatingQueryer); ok {
+		return p.rangeWithEstimate(ctx, estimator, offset, limit)
+	}
+
+	if queryCounter, ok := p.queryer.(QueryCounter[T]); ok {
+		return p.rangeWithQueryCount(ctx, queryCounter, offset, limit)
+	}
+
+	if p.opts.ConcurrentQuery {
+		return p.rangeConcurrent(ctx, offset, limit)
+	}
+
+	count, err := p.count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.rangeByCount(ctx, offset, limit, count)
+}
+
+// rangeByCount returns window for already known total count.
+func (p *Paginator[T]) rangeByCount(ctx context.Context, offset int, limit int, count int) (*Window[T], error) {
+	if count == 0 && offset == 0 {
+		return &Window[T]{}, nil
+	}
+
+	if offset >= count {
+		return nil, &RangeError{
+			Offset:         offset,
+			ItemTotalCount: count,
+		}
+	}
+
+	limit = min(limit, count-offset)
+
+	data, err := p.query(ctx, offset, limit)
 	if err != nil {
-		return nil, fmt.Errorf("query count: %w", err)
+		return nil, err
 	}
 
-	if count == 0 {
-		return &Page[T]{}, nil
+	return p.makeWindow(data, offset, limit, count), nil
+}
+
+// count returns total count from queryer.
+func (p *Paginator[T]) count(ctx context.Context) (int, error) {
+	count, err := p.queryer.Count(ctx)
+	if err != nil {
+		return 0, &QueryError{
+			Op:  "query count",
+			Err: err,
+		}
 	}
 
+	return count, nil
+}
+
+// rangeConcurrent returns window running Count and Query concurrently.
+// the first error cancels the sibling call and is returned.
+func (p *Paginator[T]) rangeConcurrent(ctx context.Context, offset int, limit int) (*Window[T], error) {
+	queryCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	var (
-		offset                       = p.pageSize * (page - 1)
-		limit                        = p.pageSize
-		pageTotalCount, lastPageSize = p.calculatePageCountAndLastPageSize(count)
+		wg    sync.WaitGroup
+		count int
 	)
 
-	if page > pageTotalCount {
-		return nil, fmt.Errorf("invalid page: %d total pages: %d", page, pageTotalCount)
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		var err error
+		if count, err = p.count(queryCtx); err != nil {
+			cancel(err)
+		}
+	}()
+
+	data, err := p.queryer.Query(queryCtx, offset, limit)
+	if err != nil {
+		cancel(&QueryError{
+			Op:  "query data",
+			Err: err,
+		})
+	}
+
+	wg.Wait()
+
+	if err := context.Cause(queryCtx); err != nil {
+		return nil, err
+	}
+
+	return p.reconcileRange(offset, limit, data, count)
+}
+
+// reconcileRange returns window for data requested with the full limit and total count fetched separately.
+func (p *Paginator[T]) reconcileRange(offset int, limit int, data []T, count int) (*Window[T], error) {
+	if count == 0 && offset == 0 {
+		return &Window[T]{}, nil
+	}
+
+	if offset >= count {
+		return nil, &RangeError{
+			Offset:         offset,
+			ItemTotalCount: count,
+		}
+	}
+
+	limit = min(limit, count-offset)
+
+	if len(data) > limit {
+		data = data[:limit]
+	}
+
+	return p.makeWindow(data, offset, limit, count), nil
+}
+
+// rangeWithQueryCount returns window fetching data and total count in one call.
+// data is always requested with the full limit since total is known only after the query,
+// at the end of collection data is truncated according to the returned total.
+// if no data returned for non zero offset total can't be taken from the query
+// (e.g. COUNT(*) OVER() returns no rows), Queryer.Count is used for range validation in this case.
+func (p *Paginator[T]) rangeWithQueryCount(
+	ctx context.Context,
+	queryCounter QueryCounter[T],
+	offset int,
+	limit int,
+) (*Window[T], error) {
+	data, count, err := queryCounter.QueryCount(ctx, offset, limit)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query data and count",
+			Err: err,
+		}
+	}
+
+	if len(data) == 0 && offset > 0 {
+		count, err = p.count(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if page == pageTotalCount {
-		limit = lastPageSize
+	return p.reconcileRange(offset, limit, data, count)
+}
+
+// rangeWithoutCount returns window without Queryer.Count call.
+// requests one extra item for detecting items after the window.
+// Queryer.Count is called only for offset past the last item if out of range policy requires totals.
+func (p *Paginator[T]) rangeWithoutCount(ctx context.Context, offset int, limit int) (*Window[T], error) {
+	data, err := p.queryer.Query(ctx, offset, limit+1)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query data",
+			Err: err,
+		}
+	}
+
+	if len(data) == 0 {
+		if offset == 0 {
+			return &Window[T]{}, nil
+		}
+
+		if p.opts.OutOfRangePolicy == OutOfRangeError {
+			return nil, &RangeError{
+				Offset:       offset,
+				TotalUnknown: true,
+			}
+		}
+
+		count, err := p.count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, &RangeError{
+			Offset:         offset,
+			ItemTotalCount: count,
+		}
+	}
+
+	if len(data) <= limit {
+		return p.makeWindow(data, offset, len(data), offset+len(data)), nil
 	}
 
-	data, err := p.queryer.Query(ctx, offset, limit)
+	window := p.makeWindow(data[:limit], offset, limit, 0)
+	window.TotalUnknown = true
+
+	return window, nil
+}
+
+// rangeWithEstimate returns window using estimated total count.
+// requests one extra item for detecting items after the window, so the short window
+// fixes total count and empty window past the actual end is returned without error.
+func (p *Paginator[T]) rangeWithEstimate(
+	ctx context.Context,
+	estimator EstimatingQueryer,
+	offset int,
+	limit int,
+) (*Window[T], error) {
+	estimate, err := estimator.EstimateCount(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("query data: %w", err)
+		return nil, &QueryError{
+			Op:  "estimate count",
+			Err: err,
+		}
 	}
 
+	data, err := p.queryer.Query(ctx, offset, limit+1)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query data",
+			Err: err,
+		}
+	}
+
+	if len(data) == 0 {
+		return &Window[T]{
+			Offset:          offset,
+			ItemTotalCount:  min(max(estimate, 0), offset),
+			TotalIsEstimate: offset > 0,
+		}, nil
+	}
+
+	if len(data) <= limit {
+		return p.makeWindow(data, offset, len(data), offset+len(data)), nil
+	}
+
+	window := p.makeWindow(data[:limit], offset, limit, max(estimate, offset+limit+1))
+	window.TotalIsEstimate = true
+
+	return window, nil
+}
+
+// makeWindow constructs window and calculates it's indexes.
+// window is marked as inconsistent if data length doesn't match expected limit.
+func (p *Paginator[T]) makeWindow(data []T, offset int, limit int, itemTotalCount int) *Window[T] {
 	var (
-		bottomIndex = offset + 1
+		bottomIndex = offset + p.opts.IndexBase
 		topIndex    = bottomIndex + len(data) - 1
 	)
 
-	return &Page[T]{
+	if len(data) == 0 {
+		bottomIndex, topIndex = 0, 0
+	}
+
+	return &Window[T]{
 		Data:           data,
+		Offset:         offset,
+		Limit:          limit,
 		BottomIndex:    bottomIndex,
 		TopIndex:       topIndex,
-		PageSize:       limit,
-		PageNumber:     page,
-		PageTotalCount: pageTotalCount,
-	}, nil
+		ItemTotalCount: itemTotalCount,
+		Inconsistent:   len(data) != limit,
+	}
+}
+
+// makePage constructs page from window fetched for page request.
+func makePage[T any](window *Window[T], req pageRequest) *Page[T] {
+	pageTotalCount, _ := calculatePageCountAndLastPageSize(window.ItemTotalCount, req.Size)
+
+	return &Page[T]{
+		Data:            window.Data,
+		Offset:          window.Offset,
+		BottomIndex:     window.BottomIndex,
+		TopIndex:        window.TopIndex,
+		PageSize:        window.Limit,
+		PageNumber:      req.Number,
+		PageTotalCount:  pageTotalCount,
+		ItemTotalCount:  window.ItemTotalCount,
+		TotalUnknown:    window.TotalUnknown,
+		TotalIsEstimate: window.TotalIsEstimate,
+		Inconsistent:    window.Inconsistent,
+	}
+}
+
+// emptyPage returns the first page of empty collection.
+func emptyPage[T any]() *Page[T] {
+	return &Page[T]{
+		PageNumber: 1,
+	}
 }
 
 // calculatePageCountAndLastPageSize returns page count and last page size.
-func (p *Paginator[T]) calculatePageCountAndLastPageSize(count int) (int, int) {
+func calculatePageCountAndLastPageSize(count int, pageSize int) (int, int) {
 	var (
-		fullPageCount = count / p.pageSize
-		lastPageSize  = count % p.pageSize
+		fullPageCount = count / pageSize
+		lastPageSize  = count % pageSize
 	)
 
 	if lastPageSize > 0 {
 		return fullPageCount + 1, lastPageSize
 	}
 
-	return fullPageCount, p.pageSize
+	return fullPageCount, pageSize
 }
@@ -0,0 +1,108 @@
+package paginator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+)
+
+func TestConcurrentQueryLastPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3, paginator.WithConcurrentQuery())
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(gomock.Any()).Return(7, nil)
+	mockQueryer.EXPECT().Query(gomock.Any(), 6, 3).Return([]int{7, 8, 9}, nil)
+
+	page, err := pag.Page(ctx, 3)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{7}, page.Data)
+	require.Equal(t, 7, page.BottomIndex)
+	require.Equal(t, 7, page.TopIndex)
+	require.Equal(t, 1, page.PageSize)
+	require.Equal(t, 3, page.PageNumber)
+	require.Equal(t, 3, page.PageTotalCount)
+	require.Equal(t, 7, page.ItemTotalCount)
+}
+
+func TestConcurrentQueryOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3, paginator.WithConcurrentQuery())
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(gomock.Any()).Return(7, nil)
+	mockQueryer.EXPECT().Query(gomock.Any(), 9, 3).Return(nil, nil)
+
+	page, err := pag.Page(ctx, 4)
+
+	var rangeErr *paginator.PageRangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 3, rangeErr.TotalPages)
+	require.Nil(t, page)
+}
+
+func TestConcurrentQueryErrorCancelsCount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryErr = errors.New("some query error")
+		pag      = paginator.New(paginator.QueryerFuncs[int]{
+			QueryFn: func(ctx context.Context, offset int, limit int) ([]int, error) {
+				return nil, queryErr
+			},
+			CountFn: func(ctx context.Context) (int, error) {
+				<-ctx.Done()
+
+				return 0, ctx.Err()
+			},
+		}, 3, paginator.WithConcurrentQuery())
+	)
+
+	page, err := pag.Page(t.Context(), 1)
+
+	require.ErrorIs(t, err, queryErr)
+	require.EqualError(t, err, "query data: some query error")
+	require.Nil(t, page)
+}
+
+func TestConcurrentCountErrorCancelsQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		countErr = errors.New("some count error")
+		pag      = paginator.New(paginator.QueryerFuncs[int]{
+			QueryFn: func(ctx context.Context, offset int, limit int) ([]int, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			},
+			CountFn: func(ctx context.Context) (int, error) {
+				return 0, countErr
+			},
+		}, 3, paginator.WithConcurrentQuery())
+	)
+
+	page, err := pag.Page(t.Context(), 1)
+
+	require.ErrorIs(t, err, countErr)
+	require.Nil(t, page)
+}
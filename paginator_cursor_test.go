@@ -0,0 +1,247 @@
+package paginator_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+	"github.com/Mikhalevich/paginator/queryercache"
+)
+
+type sliceCursorQueryer struct {
+	data []int
+}
+
+func (s *sliceCursorQueryer) QueryAfter(ctx context.Context, key *int, limit int) ([]int, error) {
+	start := 0
+	if key != nil {
+		start = sort.SearchInts(s.data, *key+1)
+	}
+
+	return s.data[start:min(start+limit, len(s.data))], nil
+}
+
+func (s *sliceCursorQueryer) QueryBefore(ctx context.Context, key int, limit int) ([]int, error) {
+	end := sort.SearchInts(s.data, key)
+
+	return s.data[max(end-limit, 0):end], nil
+}
+
+func (s *sliceCursorQueryer) Key(item int) int {
+	return item
+}
+
+func initCursorPaginator(dataLen, pageSize int) *paginator.CursorPaginator[int, int] {
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.NewCursor(&sliceCursorQueryer{data: data}, pageSize)
+}
+
+func TestCursorFirstPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initCursorPaginator(25, 10).Page(t.Context(), nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, page.Data)
+	require.Equal(t, 10, page.PageSize)
+	require.True(t, page.HasNext())
+	require.Equal(t, &paginator.Cursor[int]{Key: 10}, page.Next())
+	require.False(t, page.HasPrevious())
+	require.Nil(t, page.Previous())
+}
+
+func TestCursorForwardAndBackward(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag = initCursorPaginator(25, 10)
+		ctx = t.Context()
+	)
+
+	page, err := pag.Page(ctx, &paginator.Cursor[int]{Key: 10})
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, page.Data)
+	require.True(t, page.HasNext())
+	require.True(t, page.HasPrevious())
+
+	page, err = pag.Page(ctx, page.Next())
+
+	require.NoError(t, err)
+	require.Equal(t, []int{21, 22, 23, 24, 25}, page.Data)
+	require.Equal(t, 5, page.PageSize)
+	require.False(t, page.HasNext())
+	require.Equal(t, &paginator.Cursor[int]{Key: 21, Backward: true}, page.Previous())
+
+	page, err = pag.Page(ctx, page.Previous())
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, page.Data)
+	require.True(t, page.HasNext())
+	require.True(t, page.HasPrevious())
+
+	page, err = pag.Page(ctx, page.Previous())
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, page.Data)
+	require.True(t, page.HasNext())
+	require.False(t, page.HasPrevious())
+}
+
+func TestCursorBackwardFromBeginning(t *testing.T) {
+	t.Parallel()
+
+	page, err := initCursorPaginator(25, 10).Page(t.Context(), &paginator.Cursor[int]{Key: 1, Backward: true})
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, page.Data)
+	require.False(t, page.HasPrevious())
+}
+
+func TestCursorEmpty(t *testing.T) {
+	t.Parallel()
+
+	page, err := initCursorPaginator(0, 10).Page(t.Context(), nil)
+
+	require.NoError(t, err)
+	require.Empty(t, page.Data)
+	require.Equal(t, 0, page.PageSize)
+	require.False(t, page.HasNext())
+	require.False(t, page.HasPrevious())
+}
+
+func TestCursorQueryCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockCursorQueryer[int, int](ctrl)
+		ctx         = t.Context()
+	)
+
+	cache, err := queryercache.NewCursor(mockQueryer, queryercache.WithQueryTTL(time.Minute))
+
+	require.NoError(t, err)
+
+	pag := paginator.NewCursor(cache, 2)
+
+	mockQueryer.EXPECT().QueryAfter(ctx, gomock.Nil(), 3).Return([]int{1, 2, 3}, nil)
+	mockQueryer.EXPECT().Key(gomock.Any()).DoAndReturn(func(item int) int { return item }).AnyTimes()
+
+	testFlow := func() {
+		page, err := pag.Page(ctx, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2}, page.Data)
+		require.Equal(t, &paginator.Cursor[int]{Key: 2}, page.Next())
+	}
+
+	testFlow()
+	testFlow()
+}
+
+func TestCursorInvalidPageSize(t *testing.T) {
+	t.Parallel()
+
+	for name, size := range map[string]int{
+		"zero page size":     0,
+		"negative page size": -1,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pag, err := paginator.NewCursorWithOptions[int, int](
+				&sliceCursorQueryer{},
+				paginator.WithCursorPageSize(size),
+			)
+
+			require.ErrorIs(t, err, paginator.ErrInvalidOptions)
+			require.Nil(t, pag)
+
+			require.Panics(t, func() {
+				paginator.NewCursor(&sliceCursorQueryer{}, size)
+			})
+		})
+	}
+}
+
+func TestCursorDefaultPageSize(t *testing.T) {
+	t.Parallel()
+
+	data := make([]int, 0, 25)
+	for i := range 25 {
+		data = append(data, i+1)
+	}
+
+	pag, err := paginator.NewCursorWithOptions(&sliceCursorQueryer{data: data})
+
+	require.NoError(t, err)
+
+	page, err := pag.Page(t.Context(), nil)
+
+	require.NoError(t, err)
+	require.Len(t, page.Data, 10)
+}
+
+type compositeCursorKey struct {
+	A string
+	B string
+}
+
+func TestCursorQueryCacheCompositeKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockCursorQueryer[int, compositeCursorKey](ctrl)
+		ctx         = t.Context()
+		firstKey    = compositeCursorKey{A: "a b", B: "c"}
+		secondKey   = compositeCursorKey{A: "a", B: "b c"}
+	)
+
+	cache, err := queryercache.NewCursor(mockQueryer, queryercache.WithQueryTTL(time.Minute))
+
+	require.NoError(t, err)
+
+	mockQueryer.EXPECT().QueryAfter(ctx, &firstKey, 3).Return([]int{1, 2, 3}, nil).Times(1)
+	mockQueryer.EXPECT().QueryAfter(ctx, &secondKey, 3).Return([]int{4, 5, 6}, nil).Times(1)
+
+	for range 2 {
+		data, err := cache.QueryAfter(ctx, &firstKey, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, data)
+
+		data, err = cache.QueryAfter(ctx, &secondKey, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{4, 5, 6}, data)
+	}
+}
+
+func TestCursorQueryCacheUnsupportedKey(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	cache, err := queryercache.NewCursor(mock.NewMockCursorQueryer[int, *int](ctrl))
+
+	require.ErrorIs(t, err, queryercache.ErrUnsupportedKey)
+	require.Nil(t, cache)
+
+	timeCache, err := queryercache.NewCursor(mock.NewMockCursorQueryer[int, time.Time](ctrl))
+
+	require.NoError(t, err)
+	require.NotNil(t, timeCache)
+}
@@ -0,0 +1,138 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+)
+
+func initMockDriftPaginator(
+	t *testing.T,
+	opts ...paginator.Option,
+) (*paginator.Paginator[int], *mock.MockQueryer[int]) {
+	t.Helper()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+	)
+
+	return paginator.New(mockQueryer, pageSize, opts...), mockQueryer
+}
+
+func TestDriftInconsistentPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockDriftPaginator(t)
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(15, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 5).Return([]int{11, 12, 13}, nil),
+	)
+
+	page, err := pag.Page(ctx, 2)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{11, 12, 13}, page.Data)
+	require.Equal(t, 11, page.BottomIndex)
+	require.Equal(t, 13, page.TopIndex)
+	require.Equal(t, 5, page.PageSize)
+	require.True(t, page.Inconsistent)
+}
+
+func TestDriftRetryRecount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockDriftPaginator(t, paginator.WithDriftRetries(1))
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(15, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 5).Return([]int{11, 12, 13}, nil),
+		mockQueryer.EXPECT().Count(ctx).Return(13, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 3).Return([]int{11, 12, 13}, nil),
+	)
+
+	page, err := pag.Page(ctx, 2)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{11, 12, 13}, page.Data)
+	require.Equal(t, 3, page.PageSize)
+	require.Equal(t, 13, page.ItemTotalCount)
+	require.False(t, page.Inconsistent)
+}
+
+func TestDriftRetryOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockDriftPaginator(t, paginator.WithDriftRetries(2))
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(11, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 1).Return(nil, nil),
+		mockQueryer.EXPECT().Count(ctx).Return(10, nil),
+	)
+
+	page, err := pag.Page(ctx, 2)
+
+	var rangeErr *paginator.PageRangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 1, rangeErr.TotalPages)
+	require.Nil(t, page)
+}
+
+func TestDriftRetriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockDriftPaginator(t, paginator.WithDriftRetries(1))
+		ctx              = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(15, nil).Times(2)
+	mockQueryer.EXPECT().Query(ctx, 10, 5).Return([]int{11, 12, 13}, nil).Times(2)
+
+	page, err := pag.Page(ctx, 2)
+
+	require.NoError(t, err)
+	require.True(t, page.Inconsistent)
+}
+
+func TestDriftInconsistentEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockDriftPaginator(t)
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(12, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 2).Return(nil, nil),
+	)
+
+	page, err := pag.Page(ctx, 2)
+
+	require.NoError(t, err)
+
+	require.True(t, page.IsEmpty())
+	require.True(t, page.Inconsistent)
+	require.Equal(t, 10, page.Offset)
+	require.Zero(t, page.BottomIndex)
+	require.Zero(t, page.TopIndex)
+}
@@ -0,0 +1,68 @@
+package paginator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+func TestInvalidPageSentinel(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(101, 10).Page(t.Context(), 0)
+
+	require.ErrorIs(t, err, paginator.ErrInvalidPage)
+	require.NotErrorIs(t, err, paginator.ErrPageOutOfRange)
+	require.Nil(t, page)
+}
+
+func TestPageRangeError(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(101, 10).Page(t.Context(), 12)
+
+	require.ErrorIs(t, err, paginator.ErrPageOutOfRange)
+	require.Nil(t, page)
+
+	var rangeErr *paginator.PageRangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 12, rangeErr.Page)
+	require.Equal(t, 11, rangeErr.TotalPages)
+	require.False(t, rangeErr.TotalUnknown)
+}
+
+func TestPageRangeErrorWithoutCount(t *testing.T) {
+	t.Parallel()
+
+	_, err := initWithoutCountPaginator(101, 10).Page(t.Context(), 12)
+
+	var rangeErr *paginator.PageRangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 12, rangeErr.Page)
+	require.True(t, rangeErr.TotalUnknown)
+}
+
+func TestQueryErrorWrapping(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockPaginator(t)
+		ctx              = t.Context()
+		countErr         = errors.New("some count error")
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(0, countErr)
+
+	_, err := pag.Page(ctx, 1)
+
+	var queryErr *paginator.QueryError
+
+	require.ErrorAs(t, err, &queryErr)
+	require.Equal(t, "query count", queryErr.Op)
+	require.ErrorIs(t, err, countErr)
+}
@@ -0,0 +1,104 @@
+package paginator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+type estimatingSliceQueryer struct {
+	*queryerslice.QueryerSlice[int]
+
+	estimate int
+}
+
+func (e *estimatingSliceQueryer) EstimateCount(ctx context.Context) (int, error) {
+	return e.estimate, nil
+}
+
+func initEstimatePaginator(dataLen, estimate, pageSize int) *paginator.Paginator[int] {
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.New(&estimatingSliceQueryer{
+		QueryerSlice: queryerslice.New(data),
+		estimate:     estimate,
+	}, pageSize)
+}
+
+func TestEstimateMiddlePage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initEstimatePaginator(101, 100, 10).Page(t.Context(), 5)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{41, 42, 43, 44, 45, 46, 47, 48, 49, 50}, page.Data)
+	require.Equal(t, 41, page.BottomIndex)
+	require.Equal(t, 50, page.TopIndex)
+	require.Equal(t, 10, page.PageSize)
+	require.Equal(t, 5, page.PageNumber)
+	require.Equal(t, 10, page.PageTotalCount)
+	require.True(t, page.TotalIsEstimate)
+	require.True(t, page.HasNext())
+}
+
+func TestEstimateLowFullPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initEstimatePaginator(101, 50, 10).Page(t.Context(), 8)
+
+	require.NoError(t, err)
+
+	require.Len(t, page.Data, 10)
+	require.Equal(t, 9, page.PageTotalCount)
+	require.True(t, page.TotalIsEstimate)
+	require.True(t, page.HasNext())
+}
+
+func TestEstimateHighShortLastPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initEstimatePaginator(101, 200, 10).Page(t.Context(), 11)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{101}, page.Data)
+	require.Equal(t, 1, page.PageSize)
+	require.Equal(t, 11, page.PageNumber)
+	require.Equal(t, 11, page.PageTotalCount)
+	require.False(t, page.TotalIsEstimate)
+	require.False(t, page.HasNext())
+}
+
+func TestEstimateHighEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initEstimatePaginator(101, 200, 10).Page(t.Context(), 15)
+
+	require.NoError(t, err)
+
+	require.Empty(t, page.Data)
+	require.Equal(t, 15, page.PageNumber)
+	require.Equal(t, 14, page.PageTotalCount)
+	require.True(t, page.TotalIsEstimate)
+	require.False(t, page.HasNext())
+}
+
+func TestEstimateEmpty(t *testing.T) {
+	t.Parallel()
+
+	page, err := initEstimatePaginator(0, 10, 10).Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	require.Empty(t, page.Data)
+	require.Equal(t, 0, page.PageTotalCount)
+	require.False(t, page.HasNext())
+}
@@ -0,0 +1,237 @@
+package paginator_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+type fetchAllQueryer struct {
+	Data      []int
+	FailAt    int
+	Delay     func(offset int) time.Duration
+	Counts    atomic.Int32
+	InFlight  atomic.Int32
+	MaxFlight atomic.Int32
+}
+
+func (f *fetchAllQueryer) Query(ctx context.Context, offset int, limit int) ([]int, error) {
+	inFlight := f.InFlight.Add(1)
+	defer f.InFlight.Add(-1)
+
+	for {
+		maxFlight := f.MaxFlight.Load()
+		if inFlight <= maxFlight || f.MaxFlight.CompareAndSwap(maxFlight, inFlight) {
+			break
+		}
+	}
+
+	if f.Delay != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(f.Delay(offset)):
+		}
+	}
+
+	if f.FailAt > 0 && offset == f.FailAt {
+		return nil, errQueryFailed
+	}
+
+	return f.Data[min(offset, len(f.Data)):min(offset+limit, len(f.Data))], nil
+}
+
+func (f *fetchAllQueryer) Count(ctx context.Context) (int, error) {
+	f.Counts.Add(1)
+
+	return len(f.Data), nil
+}
+
+var errQueryFailed = errors.New("query failed")
+
+func makeFetchAllData(count int) []int {
+	data := make([]int, 0, count)
+
+	for i := range count {
+		data = append(data, i+1)
+	}
+
+	return data
+}
+
+func TestFetchAllOrdered(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = &fetchAllQueryer{
+			Data: makeFetchAllData(23),
+			Delay: func(offset int) time.Duration {
+				return time.Duration(25-offset) * time.Millisecond / 5
+			},
+		}
+		pag     = paginator.New(queryer, 5)
+		numbers []int
+		items   []int
+	)
+
+	for page, err := range pag.FetchAll(t.Context(), paginator.WithConcurrency(3)) {
+		require.NoError(t, err)
+
+		numbers = append(numbers, page.PageNumber)
+		items = append(items, page.Data...)
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, numbers)
+	require.Equal(t, makeFetchAllData(23), items)
+	require.EqualValues(t, 1, queryer.Counts.Load())
+	require.LessOrEqual(t, queryer.MaxFlight.Load(), int32(3))
+	require.Greater(t, queryer.MaxFlight.Load(), int32(1))
+}
+
+func TestFetchAllEmpty(t *testing.T) {
+	t.Parallel()
+
+	pag := paginator.New(&fetchAllQueryer{}, 5)
+
+	for range pag.FetchAll(t.Context(), paginator.WithConcurrency(2)) {
+		require.Fail(t, "unexpected page")
+	}
+}
+
+func TestFetchAllFailFast(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = &fetchAllQueryer{
+			Data:   makeFetchAllData(50),
+			FailAt: 10,
+			Delay: func(offset int) time.Duration {
+				return time.Millisecond
+			},
+		}
+		pag     = paginator.New(queryer, 5)
+		numbers []int
+		lastErr error
+	)
+
+	for page, err := range pag.FetchAll(t.Context(), paginator.WithConcurrency(4)) {
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		numbers = append(numbers, page.PageNumber)
+	}
+
+	require.Equal(t, []int{1, 2}, numbers)
+	require.ErrorIs(t, lastErr, errQueryFailed)
+	require.ErrorContains(t, lastErr, "page 3")
+	require.Zero(t, queryer.InFlight.Load())
+}
+
+func TestFetchAllBreak(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = &fetchAllQueryer{
+			Data: makeFetchAllData(100),
+			Delay: func(offset int) time.Duration {
+				return time.Millisecond
+			},
+		}
+		pag = paginator.New(queryer, 5)
+	)
+
+	for page, err := range pag.FetchAll(t.Context(), paginator.WithConcurrency(4)) {
+		require.NoError(t, err)
+		require.Equal(t, 1, page.PageNumber)
+
+		break
+	}
+
+	require.Zero(t, queryer.InFlight.Load())
+}
+
+func TestFetchAllContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = &fetchAllQueryer{
+			Data: makeFetchAllData(100),
+			Delay: func(offset int) time.Duration {
+				return time.Millisecond
+			},
+		}
+		pag         = paginator.New(queryer, 5)
+		ctx, cancel = context.WithCancel(t.Context())
+		lastErr     error
+		pages       int
+	)
+
+	defer cancel()
+
+	for page, err := range pag.FetchAll(ctx, paginator.WithConcurrency(2)) {
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		pages++
+
+		if page.PageNumber == 2 {
+			cancel()
+		}
+	}
+
+	require.ErrorIs(t, lastErr, context.Canceled)
+	require.Less(t, pages, 20)
+}
+
+type estimatingFetchAllQueryer struct {
+	*fetchAllQueryer
+}
+
+func (e *estimatingFetchAllQueryer) EstimateCount(ctx context.Context) (int, error) {
+	return len(e.Data), nil
+}
+
+func TestFetchAllWithoutExactCount(t *testing.T) {
+	t.Parallel()
+
+	for name, init := range map[string]func(queryer *fetchAllQueryer) *paginator.Paginator[int]{
+		"without count": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(queryer, 5, paginator.WithoutCount())
+		},
+		"estimate": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(&estimatingFetchAllQueryer{fetchAllQueryer: queryer}, 5)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				queryer = &fetchAllQueryer{Data: makeFetchAllData(12)}
+				pag     = init(queryer)
+				items   []int
+			)
+
+			for page, err := range pag.FetchAll(t.Context(), paginator.WithConcurrency(3)) {
+				require.NoError(t, err)
+
+				items = append(items, page.Data...)
+			}
+
+			require.Equal(t, makeFetchAllData(12), items)
+			require.Zero(t, queryer.Counts.Load())
+			require.EqualValues(t, 1, queryer.MaxFlight.Load())
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package paginator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+func TestPagesIterator(t *testing.T) {
+	t.Parallel()
+
+	var pageNumbers []int
+
+	for page, err := range initSlicePaginator(25, 10).Pages(t.Context()) {
+		require.NoError(t, err)
+
+		pageNumbers = append(pageNumbers, page.PageNumber)
+	}
+
+	require.Equal(t, []int{1, 2, 3}, pageNumbers)
+}
+
+func TestPagesIteratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	for range initSlicePaginator(0, 10).Pages(t.Context()) {
+		require.Fail(t, "unexpected page")
+	}
+}
+
+func TestItemsIterator(t *testing.T) {
+	t.Parallel()
+
+	var items []int
+
+	for item, err := range initSlicePaginator(25, 10).Items(t.Context()) {
+		require.NoError(t, err)
+
+		items = append(items, item)
+	}
+
+	require.Len(t, items, 25)
+	require.Equal(t, 1, items[0])
+	require.Equal(t, 25, items[24])
+}
+
+func TestItemsIteratorBreak(t *testing.T) {
+	t.Parallel()
+
+	var items []int
+
+	for item, err := range initSlicePaginator(25, 10).Items(t.Context()) {
+		require.NoError(t, err)
+
+		items = append(items, item)
+
+		if len(items) == 12 {
+			break
+		}
+	}
+
+	require.Len(t, items, 12)
+}
+
+func TestItemsIteratorQueryError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockPaginator(t)
+		ctx              = t.Context()
+		items            []int
+		iterErr          error
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(11, nil).Times(2)
+	mockQueryer.EXPECT().Query(ctx, 0, pageSize).Return([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nil)
+	mockQueryer.EXPECT().Query(ctx, 10, 1).Return(nil, errors.New("some query error"))
+
+	for item, err := range pag.Items(ctx) {
+		if err != nil {
+			iterErr = err
+
+			break
+		}
+
+		items = append(items, item)
+	}
+
+	require.Len(t, items, 10)
+	require.EqualError(t, iterErr, "page 2: query data: some query error")
+}
+
+func TestPagesIteratorContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var iterErr error
+
+	for _, err := range initSlicePaginator(25, 10).Pages(ctx) {
+		if err != nil {
+			iterErr = err
+
+			break
+		}
+
+		cancel()
+	}
+
+	require.ErrorIs(t, iterErr, context.Canceled)
+}
+
+func TestPagesIteratorInconsistentEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockPaginator(t)
+		ctx              = t.Context()
+		pages            []*paginator.Page[int]
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(15, nil),
+		mockQueryer.EXPECT().Query(ctx, 0, pageSize).Return(nil, nil),
+		mockQueryer.EXPECT().Count(ctx).Return(15, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 5).Return([]int{11, 12, 13, 14, 15}, nil),
+	)
+
+	for page, err := range pag.Pages(ctx) {
+		require.NoError(t, err)
+
+		pages = append(pages, page)
+	}
+
+	require.Len(t, pages, 2)
+	require.True(t, pages[0].IsEmpty())
+	require.True(t, pages[0].Inconsistent)
+	require.Equal(t, []int{11, 12, 13, 14, 15}, pages[1].Data)
+}
+
+func TestPagesIteratorInconsistentLastPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockPaginator(t)
+		ctx              = t.Context()
+		pages            []*paginator.Page[int]
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(5, nil),
+		mockQueryer.EXPECT().Query(ctx, 0, 5).Return(nil, nil),
+	)
+
+	for page, err := range pag.Pages(ctx) {
+		require.NoError(t, err)
+
+		pages = append(pages, page)
+	}
+
+	require.Len(t, pages, 1)
+	require.True(t, pages[0].Inconsistent)
+	require.Equal(t, 5, pages[0].ItemTotalCount)
+}
@@ -0,0 +1,86 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+func TestPageMetadata(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		page    int
+		offset  int
+		isFirst bool
+		isLast  bool
+	}{
+		"first":  {page: 1, offset: 0, isFirst: true},
+		"middle": {page: 5, offset: 40},
+		"last":   {page: 11, offset: 100, isLast: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			page, err := initSlicePaginator(101, 10).Page(t.Context(), tc.page)
+
+			require.NoError(t, err)
+
+			require.Equal(t, tc.offset, page.Offset)
+			require.Equal(t, 101, page.ItemTotalCount)
+			require.Equal(t, 11, page.PageTotalCount)
+			require.Equal(t, tc.isFirst, page.IsFirst())
+			require.Equal(t, tc.isLast, page.IsLast())
+			require.False(t, page.IsEmpty())
+		})
+	}
+}
+
+func TestEmptyCollectionSecondPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initSlicePaginator(0, 10).Page(t.Context(), 2)
+
+	var rangeErr *paginator.PageRangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 2, rangeErr.Page)
+	require.Equal(t, 0, rangeErr.TotalPages)
+	require.Nil(t, page)
+}
+
+func TestEmptyCollectionClamp(t *testing.T) {
+	t.Parallel()
+
+	page, err := initOutOfRangePaginator(0, 10,
+		paginator.WithOutOfRangePolicy(paginator.OutOfRangeClamp)).Page(t.Context(), 3)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, page.PageNumber)
+	require.Equal(t, 0, page.PageTotalCount)
+	require.True(t, page.IsEmpty())
+}
+
+func TestWithoutCountMetadata(t *testing.T) {
+	t.Parallel()
+
+	pag := initWithoutCountPaginator(25, 10)
+
+	page, err := pag.Page(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Equal(t, 10, page.Offset)
+	require.Equal(t, 0, page.ItemTotalCount)
+	require.True(t, page.TotalUnknown)
+	require.False(t, page.IsLast())
+
+	page, err = pag.Page(t.Context(), 3)
+
+	require.NoError(t, err)
+	require.Equal(t, 20, page.Offset)
+	require.Equal(t, 25, page.ItemTotalCount)
+	require.Equal(t, 3, page.PageTotalCount)
+	require.True(t, page.IsLast())
+}
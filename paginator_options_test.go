@@ -0,0 +1,82 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+func TestNewWithOptionsInvalid(t *testing.T) {
+	t.Parallel()
+
+	queryer := queryerslice.New([]int{1, 2, 3})
+
+	for name, opts := range map[string][]paginator.Option{
+		"zero page size":       {paginator.WithPageSize(0)},
+		"negative page size":   {paginator.WithPageSize(-1)},
+		"page size below min":  {paginator.WithPageSize(5), paginator.WithPageSizeBounds(10, 100)},
+		"page size above max":  {paginator.WithPageSize(500), paginator.WithPageSizeBounds(10, 100)},
+		"max less than min":    {paginator.WithPageSizeBounds(10, 5)},
+		"zero min page size":   {paginator.WithPageSizeBounds(0, 5)},
+		"invalid index base":   {paginator.WithIndexBase(2)},
+		"invalid range policy": {paginator.WithOutOfRangePolicy(paginator.OutOfRangePolicy(100))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pag, err := paginator.NewWithOptions(queryer, opts...)
+
+			require.ErrorIs(t, err, paginator.ErrInvalidOptions)
+			require.Nil(t, pag)
+		})
+	}
+}
+
+func TestNewWithOptionsDefaultPageSize(t *testing.T) {
+	t.Parallel()
+
+	data := make([]int, 0, 25)
+	for i := range 25 {
+		data = append(data, i+1)
+	}
+
+	pag, err := paginator.NewWithOptions(queryerslice.New(data))
+
+	require.NoError(t, err)
+
+	page, err := pag.Page(t.Context(), 1)
+
+	require.NoError(t, err)
+	require.Len(t, page.Data, 10)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func TestNewInvalidPageSizePanics(t *testing.T) {
+	t.Parallel()
+
+	require.Panics(t, func() {
+		paginator.New(queryerslice.New([]int{1, 2, 3}), 0)
+	})
+}
+
+func TestIndexBaseZero(t *testing.T) {
+	t.Parallel()
+
+	pag, err := paginator.NewWithOptions(
+		queryerslice.New([]int{1, 2, 3, 4, 5}),
+		paginator.WithPageSize(2),
+		paginator.WithIndexBase(0),
+	)
+
+	require.NoError(t, err)
+
+	page, err := pag.Page(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 4}, page.Data)
+	require.Equal(t, 2, page.BottomIndex)
+	require.Equal(t, 3, page.TopIndex)
+}
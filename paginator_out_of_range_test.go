@@ -0,0 +1,116 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+func initOutOfRangePaginator(
+	dataLen, pageSize int,
+	opts ...paginator.Option,
+) *paginator.Paginator[int] {
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.New(queryerslice.New(data), pageSize, opts...)
+}
+
+func TestOutOfRangeClamp(t *testing.T) {
+	t.Parallel()
+
+	testCase := func(t *testing.T, pg *paginator.Paginator[int]) {
+		t.Helper()
+
+		page, err := pg.Page(t.Context(), 15)
+
+		require.NoError(t, err)
+
+		require.Equal(t, []int{101}, page.Data)
+		require.Equal(t, 101, page.BottomIndex)
+		require.Equal(t, 101, page.TopIndex)
+		require.Equal(t, 1, page.PageSize)
+		require.Equal(t, 11, page.PageNumber)
+		require.Equal(t, 11, page.PageTotalCount)
+	}
+
+	t.Run("count", func(t *testing.T) {
+		t.Parallel()
+		testCase(t, initOutOfRangePaginator(101, 10,
+			paginator.WithOutOfRangePolicy(paginator.OutOfRangeClamp)))
+	})
+
+	t.Run("without count", func(t *testing.T) {
+		t.Parallel()
+		testCase(t, initOutOfRangePaginator(101, 10,
+			paginator.WithOutOfRangePolicy(paginator.OutOfRangeClamp), paginator.WithoutCount()))
+	})
+}
+
+func TestOutOfRangeEmpty(t *testing.T) {
+	t.Parallel()
+
+	testCase := func(t *testing.T, pg *paginator.Paginator[int]) {
+		t.Helper()
+
+		page, err := pg.Page(t.Context(), 15)
+
+		require.NoError(t, err)
+
+		require.Empty(t, page.Data)
+		require.Equal(t, 0, page.PageSize)
+		require.Equal(t, 15, page.PageNumber)
+		require.Equal(t, 11, page.PageTotalCount)
+		require.False(t, page.HasNext())
+		require.True(t, page.HasPrevious())
+		require.Equal(t, 11, page.Previous())
+	}
+
+	t.Run("count", func(t *testing.T) {
+		t.Parallel()
+		testCase(t, initOutOfRangePaginator(101, 10,
+			paginator.WithOutOfRangePolicy(paginator.OutOfRangeEmpty)))
+	})
+
+	t.Run("without count", func(t *testing.T) {
+		t.Parallel()
+		testCase(t, initOutOfRangePaginator(101, 10,
+			paginator.WithOutOfRangePolicy(paginator.OutOfRangeEmpty), paginator.WithoutCount()))
+	})
+}
+
+func TestOutOfRangeError(t *testing.T) {
+	t.Parallel()
+
+	page, err := initOutOfRangePaginator(101, 10,
+		paginator.WithOutOfRangePolicy(paginator.OutOfRangeError)).Page(t.Context(), 15)
+
+	require.ErrorIs(t, err, paginator.ErrPageOutOfRange)
+	require.Nil(t, page)
+}
+
+func TestOutOfRangeQueryCountClamp(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t,
+			paginator.WithOutOfRangePolicy(paginator.OutOfRangeClamp))
+		ctx = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 9, 3).Return(nil, 0, nil)
+	mockQueryer.MockQueryer.EXPECT().Count(ctx).Return(7, nil)
+	mockQueryer.MockQueryer.EXPECT().Query(ctx, 6, 1).Return([]int{7}, nil)
+
+	page, err := pag.Page(ctx, 4)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{7}, page.Data)
+	require.Equal(t, 3, page.PageNumber)
+	require.Equal(t, 3, page.PageTotalCount)
+}
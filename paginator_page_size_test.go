@@ -0,0 +1,93 @@
+package paginator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryercache"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+func initBoundedPaginator(dataLen int) *paginator.Paginator[int] {
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.New(queryerslice.New(data), 10, paginator.WithPageSizeBounds(5, 20))
+}
+
+func TestPageWithSize(t *testing.T) {
+	t.Parallel()
+
+	page, err := initBoundedPaginator(101).PageWithSize(t.Context(), 3, 7)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{15, 16, 17, 18, 19, 20, 21}, page.Data)
+	require.Equal(t, 15, page.BottomIndex)
+	require.Equal(t, 21, page.TopIndex)
+	require.Equal(t, 7, page.PageSize)
+	require.Equal(t, 3, page.PageNumber)
+	require.Equal(t, 15, page.PageTotalCount)
+}
+
+func TestPageWithSizeBounds(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		size         int
+		expectedSize int
+	}{
+		"default": {size: 0, expectedSize: 10},
+		"min":     {size: 1, expectedSize: 5},
+		"max":     {size: 100, expectedSize: 20},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			page, err := initBoundedPaginator(101).PageWithSize(t.Context(), 1, tc.size)
+
+			require.NoError(t, err)
+			require.Len(t, page.Data, tc.expectedSize)
+			require.Equal(t, tc.expectedSize, page.PageSize)
+		})
+	}
+}
+
+func TestPageWithSizeCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockCachedPaginator(
+			t,
+			queryercache.WithCountTTL(time.Minute),
+			queryercache.WithQueryTTL(time.Minute),
+		)
+		ctx = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(30, nil),
+		mockQueryer.EXPECT().Query(ctx, 0, 10).Return([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nil),
+		mockQueryer.EXPECT().Query(ctx, 0, 3).Return([]int{1, 2, 3}, nil),
+	)
+
+	for range 2 {
+		page, err := pag.Page(ctx, 1)
+
+		require.NoError(t, err)
+		require.Len(t, page.Data, 10)
+		require.Equal(t, 3, page.PageTotalCount)
+
+		page, err = pag.PageWithSize(ctx, 1, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, page.Data)
+		require.Equal(t, 10, page.PageTotalCount)
+	}
+}
@@ -0,0 +1,184 @@
+package paginator_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+)
+
+func TestPagesByNumberAdjacent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3)
+		ctx         = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(11, nil),
+		mockQueryer.EXPECT().Query(ctx, 3, 8).Return([]int{4, 5, 6, 7, 8, 9, 10, 11}, nil),
+	)
+
+	pages, err := pag.PagesByNumber(ctx, []int{3, 2, 4, 3})
+
+	require.NoError(t, err)
+	require.Len(t, pages, 3)
+
+	require.NoError(t, pages[2].Err)
+	require.Equal(t, []int{4, 5, 6}, pages[2].Page.Data)
+	require.Equal(t, 2, pages[2].Page.PageNumber)
+
+	require.NoError(t, pages[3].Err)
+	require.Equal(t, []int{7, 8, 9}, pages[3].Page.Data)
+	require.Equal(t, 7, pages[3].Page.BottomIndex)
+
+	require.NoError(t, pages[4].Err)
+	require.Equal(t, []int{10, 11}, pages[4].Page.Data)
+	require.Equal(t, 2, pages[4].Page.PageSize)
+	require.True(t, pages[4].Page.IsLast())
+	require.False(t, pages[4].Page.Inconsistent)
+}
+
+func TestPagesByNumberSeparateRanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3)
+		ctx         = t.Context()
+		errQuery    = errors.New("query error")
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(20, nil)
+	mockQueryer.EXPECT().Query(ctx, 0, 6).Return([]int{1, 2, 3, 4, 5, 6}, nil)
+	mockQueryer.EXPECT().Query(ctx, 15, 3).Return(nil, errQuery)
+
+	pages, err := pag.PagesByNumber(ctx, []int{1, 2, 6, 0, 9})
+
+	require.NoError(t, err)
+	require.Len(t, pages, 5)
+
+	require.Equal(t, []int{1, 2, 3}, pages[1].Page.Data)
+	require.Equal(t, []int{4, 5, 6}, pages[2].Page.Data)
+
+	require.ErrorIs(t, pages[6].Err, errQuery)
+	require.Nil(t, pages[6].Page)
+
+	require.ErrorIs(t, pages[0].Err, paginator.ErrInvalidPage)
+	require.ErrorIs(t, pages[9].Err, paginator.ErrPageOutOfRange)
+}
+
+func TestPagesByNumberEmpty(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3, paginator.WithOutOfRangePolicy(paginator.OutOfRangeEmpty))
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(0, nil)
+
+	pages, err := pag.PagesByNumber(ctx, []int{1, 2})
+
+	require.NoError(t, err)
+	require.True(t, pages[1].Page.IsEmpty())
+	require.Equal(t, 1, pages[1].Page.PageNumber)
+	require.True(t, pages[2].Page.IsEmpty())
+	require.Equal(t, 2, pages[2].Page.PageNumber)
+}
+
+func TestPagesByNumberCountError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3)
+		ctx         = t.Context()
+		errCount    = errors.New("count error")
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(0, errCount)
+
+	pages, err := pag.PagesByNumber(ctx, []int{1, 2})
+
+	var queryErr *paginator.QueryError
+
+	require.ErrorAs(t, err, &queryErr)
+	require.ErrorIs(t, err, errCount)
+	require.Nil(t, pages)
+}
+
+func TestPagesByNumberConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = &fetchAllQueryer{
+			Data:  makeFetchAllData(60),
+			Delay: func(int) time.Duration { return 10 * time.Millisecond },
+		}
+		pag = paginator.New(queryer, 5)
+	)
+
+	pages, err := pag.PagesByNumber(t.Context(), []int{1, 3, 5, 7, 9, 11}, paginator.WithConcurrency(2))
+
+	require.NoError(t, err)
+	require.Len(t, pages, 6)
+
+	for number, res := range pages {
+		require.NoError(t, res.Err)
+		require.Equal(t, number, res.Page.PageNumber)
+	}
+
+	require.EqualValues(t, 1, queryer.Counts.Load())
+	require.EqualValues(t, 2, queryer.MaxFlight.Load())
+}
+
+func TestPagesByNumberWithoutExactCount(t *testing.T) {
+	t.Parallel()
+
+	for name, init := range map[string]func(queryer *fetchAllQueryer) *paginator.Paginator[int]{
+		"without count": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(queryer, 5, paginator.WithoutCount())
+		},
+		"estimate": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(&estimatingFetchAllQueryer{fetchAllQueryer: queryer}, 5)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				queryer = &fetchAllQueryer{Data: makeFetchAllData(12)}
+				pag     = init(queryer)
+			)
+
+			pages, err := pag.PagesByNumber(t.Context(), []int{3, 1, 0, 1}, paginator.WithConcurrency(1))
+
+			require.NoError(t, err)
+			require.Len(t, pages, 3)
+
+			require.Equal(t, makeFetchAllData(5), pages[1].Page.Data)
+			require.True(t, pages[1].Page.HasNext())
+
+			require.Equal(t, []int{11, 12}, pages[3].Page.Data)
+			require.True(t, pages[3].Page.IsLast())
+
+			require.ErrorIs(t, pages[0].Err, paginator.ErrInvalidPage)
+
+			require.Zero(t, queryer.Counts.Load())
+			require.EqualValues(t, 1, queryer.MaxFlight.Load())
+		})
+	}
+}
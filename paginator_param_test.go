@@ -0,0 +1,246 @@
+package paginator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+	"github.com/Mikhalevich/paginator/queryercache"
+	"github.com/Mikhalevich/paginator/queryerslice"
+	"github.com/Mikhalevich/paginator/spec"
+)
+
+type testParams struct {
+	Status string
+	Sort   string
+}
+
+func TestParamPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+		pag         = paginator.NewParam(mockQueryer, 5)
+		ctx         = t.Context()
+		params      = testParams{Status: "open", Sort: "-id"}
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx, params).Return(12, nil),
+		mockQueryer.EXPECT().Query(ctx, params, 5, 5).Return([]int{6, 7, 8, 9, 10}, nil),
+	)
+
+	page, err := pag.Page(ctx, params, 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{6, 7, 8, 9, 10}, page.Data)
+	require.Equal(t, 3, page.PageTotalCount)
+	require.Equal(t, 12, page.ItemTotalCount)
+}
+
+func TestParamPageWithSize(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+		pag         = paginator.NewParam(mockQueryer, 5, paginator.WithPageSizeBounds(1, 8))
+		ctx         = t.Context()
+		params      = testParams{Status: "closed"}
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx, params).Return(20, nil),
+		mockQueryer.EXPECT().Query(ctx, params, 8, 8).Return([]int{9, 10, 11, 12, 13, 14, 15, 16}, nil),
+	)
+
+	page, err := pag.PageWithSize(ctx, params, 2, 100)
+
+	require.NoError(t, err)
+	require.Equal(t, 8, page.PageSize)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func TestParamBindPages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+		pag         = paginator.NewParam(mockQueryer, 5)
+		ctx         = t.Context()
+		params      = testParams{Status: "open"}
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx, params).Return(7, nil),
+		mockQueryer.EXPECT().Query(ctx, params, 0, 5).Return([]int{1, 2, 3, 4, 5}, nil),
+		mockQueryer.EXPECT().Count(ctx, params).Return(7, nil),
+		mockQueryer.EXPECT().Query(ctx, params, 5, 2).Return([]int{6, 7}, nil),
+	)
+
+	var items []int
+
+	for item, err := range pag.Bind(params).Items(ctx) {
+		require.NoError(t, err)
+
+		items = append(items, item)
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, items)
+}
+
+func TestParamInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+	)
+
+	pag, err := paginator.NewParamWithOptions(mockQueryer, paginator.WithPageSize(0))
+
+	require.ErrorIs(t, err, paginator.ErrInvalidOptions)
+	require.Nil(t, pag)
+}
+
+func TestParamQueryerCacheKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+		ctx         = t.Context()
+		openParams  = testParams{Status: "open"}
+		closeParams = testParams{Status: "closed"}
+	)
+
+	cache, err := queryercache.NewParam(mockQueryer)
+
+	require.NoError(t, err)
+
+	pag := paginator.NewParam(cache, 5)
+
+	mockQueryer.EXPECT().Count(ctx, openParams).Return(3, nil).Times(1)
+	mockQueryer.EXPECT().Query(ctx, openParams, 0, 3).Return([]int{1, 2, 3}, nil).Times(1)
+	mockQueryer.EXPECT().Count(ctx, closeParams).Return(2, nil).Times(1)
+	mockQueryer.EXPECT().Query(ctx, closeParams, 0, 2).Return([]int{4, 5}, nil).Times(1)
+
+	for range 2 {
+		page, err := pag.Page(ctx, openParams, 1)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, page.Data)
+
+		page, err = pag.Page(ctx, closeParams, 1)
+
+		require.NoError(t, err)
+		require.Equal(t, []int{4, 5}, page.Data)
+	}
+}
+
+func TestParamSliceSpec(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = queryerslice.NewSpec([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, spec.Fields[int]{
+			"value": func(item int) any { return item },
+		})
+		pag = paginator.NewParam(queryer, 3)
+	)
+
+	params, err := spec.ParseQuery(
+		"sort=-value&filter[value][gt]=2",
+		spec.WithSortFields("value"),
+		spec.WithFilterFields("value"),
+	)
+
+	require.NoError(t, err)
+
+	page, err := pag.Page(t.Context(), params, 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{7, 6, 5}, page.Data)
+	require.Equal(t, 8, page.ItemTotalCount)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func TestParamQueryerCacheIndependentExpiry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockParamQueryer[int, testParams](ctrl)
+		ctx         = t.Context()
+		openParams  = testParams{Status: "open"}
+		closeParams = testParams{Status: "closed"}
+	)
+
+	cache, err := queryercache.NewParam(mockQueryer, queryercache.WithCountTTL(20*time.Millisecond))
+
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx, openParams).Return(1, nil),
+		mockQueryer.EXPECT().Count(ctx, closeParams).Return(2, nil),
+		mockQueryer.EXPECT().Count(ctx, openParams).Return(10, nil),
+		mockQueryer.EXPECT().Count(ctx, closeParams).Return(20, nil),
+	)
+
+	for _, expected := range []struct {
+		Params testParams
+		Count  int
+	}{
+		{Params: openParams, Count: 1},
+		{Params: closeParams, Count: 2},
+		{Params: openParams, Count: 1},
+	} {
+		count, err := cache.Count(ctx, expected.Params)
+
+		require.NoError(t, err)
+		require.Equal(t, expected.Count, count)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	count, err := cache.Count(ctx, openParams)
+
+	require.NoError(t, err)
+	require.Equal(t, 10, count)
+
+	count, err = cache.Count(ctx, closeParams)
+
+	require.NoError(t, err)
+	require.Equal(t, 20, count)
+}
+
+func TestParamQueryerCacheUnsupportedParams(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	pointerCache, err := queryercache.NewParam(mock.NewMockParamQueryer[int, *testParams](ctrl))
+
+	require.ErrorIs(t, err, queryercache.ErrUnsupportedParams)
+	require.Nil(t, pointerCache)
+
+	nestedCache, err := queryercache.NewParam(mock.NewMockParamQueryer[int, []map[string]*int](ctrl))
+
+	require.ErrorIs(t, err, queryercache.ErrUnsupportedParams)
+	require.Nil(t, nestedCache)
+
+	specCache, err := queryercache.NewParam(mock.NewMockParamQueryer[int, spec.Spec](ctrl))
+
+	require.NoError(t, err)
+	require.NotNil(t, specCache)
+
+	timeCache, err := queryercache.NewParam(mock.NewMockParamQueryer[int, struct{ From time.Time }](ctrl))
+
+	require.NoError(t, err)
+	require.NotNil(t, timeCache)
+}
@@ -0,0 +1,133 @@
+package paginator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+	"github.com/Mikhalevich/paginator/queryercache"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+type positionItem struct {
+	ID   string
+	Rank int
+}
+
+func TestPageOfSlice(t *testing.T) {
+	t.Parallel()
+
+	items := make([]positionItem, 0, 23)
+
+	for i := range 23 {
+		items = append(items, positionItem{
+			ID:   "id" + string(rune('a'+i)),
+			Rank: i,
+		})
+	}
+
+	var (
+		queryer = queryerslice.NewKeyed(items, func(item positionItem) string { return item.ID })
+		pag     = paginator.New(queryer, pageSize)
+		ctx     = t.Context()
+	)
+
+	location, err := paginator.PageOf(ctx, pag, "idn")
+
+	require.NoError(t, err)
+	require.Equal(t, paginator.ItemLocation{Page: 2, Index: 3}, location)
+
+	page, err := pag.Page(ctx, location.Page)
+
+	require.NoError(t, err)
+	require.Equal(t, "idn", page.Data[location.Index].ID)
+
+	location, err = paginator.PageOf(ctx, pag, "ida")
+
+	require.NoError(t, err)
+	require.Equal(t, paginator.ItemLocation{Page: 1, Index: 0}, location)
+
+	location, err = paginator.PageOf(ctx, pag, "missing")
+
+	require.ErrorIs(t, err, paginator.ErrItemNotFound)
+	require.Zero(t, location)
+}
+
+func TestPageOfQueryError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockPositionQueryer[int, int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize)
+		ctx         = t.Context()
+		errPosition = errors.New("position error")
+	)
+
+	mockQueryer.EXPECT().Position(ctx, 42).Return(0, false, errPosition)
+
+	location, err := paginator.PageOf(ctx, pag, 42)
+
+	var queryErr *paginator.QueryError
+
+	require.ErrorAs(t, err, &queryErr)
+	require.ErrorIs(t, err, errPosition)
+	require.Zero(t, location)
+}
+
+func TestPageOfNotSupported(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer = queryerslice.NewKeyed([]int{1, 2, 3}, func(item int) int { return item })
+		ctx     = t.Context()
+	)
+
+	_, err := paginator.PageOf(ctx, paginator.New(queryerslice.New([]int{1, 2, 3}), pageSize), 1)
+
+	require.ErrorIs(t, err, paginator.ErrPositionNotSupported)
+
+	_, err = paginator.PageOf(ctx, paginator.New(queryercache.New(queryer), pageSize), 1)
+
+	require.ErrorIs(t, err, paginator.ErrPositionNotSupported)
+
+	_, err = paginator.PageOf(ctx, paginator.New(queryer, pageSize), "1")
+
+	require.ErrorIs(t, err, paginator.ErrPositionNotSupported)
+}
+
+func TestPageOfPositionCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockPositionQueryer[int, int](ctrl)
+		pag         = paginator.New(queryercache.NewPosition(mockQueryer), pageSize)
+		ctx         = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Position(ctx, 42).Return(12, true, nil),
+		mockQueryer.EXPECT().Count(ctx).Return(20, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 10).Return([]int{11, 12, 42, 14, 15, 16, 17, 18, 19, 20}, nil),
+	)
+
+	location, err := paginator.PageOf(ctx, pag, 42)
+
+	require.NoError(t, err)
+	require.Equal(t, paginator.ItemLocation{Page: 2, Index: 2}, location)
+
+	page, err := pag.Page(ctx, location.Page)
+
+	require.NoError(t, err)
+	require.Equal(t, 42, page.Data[location.Index])
+
+	page, err = pag.Page(ctx, location.Page)
+
+	require.NoError(t, err)
+	require.Equal(t, 42, page.Data[location.Index])
+}
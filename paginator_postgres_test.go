@@ -3,6 +3,7 @@ package paginator_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"testing"
@@ -237,6 +238,32 @@ func (s *SqlQueryProvider) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+func (s *SqlQueryProvider) Position(ctx context.Context, id int) (int, bool, error) {
+	var position int
+
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT
+			position
+		FROM (
+			SELECT
+				id,
+				ROW_NUMBER() OVER (ORDER BY int_field) - 1 AS position
+			FROM
+				test
+		) positions
+		WHERE
+			id = $1
+	`, id).Scan(&position); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+
+		return 0, false, fmt.Errorf("query row context: %w", err)
+	}
+
+	return position, true, nil
+}
+
 func (s *PaginatorPostgres) TestFirstPage() {
 	var (
 		ctx = context.Background()
@@ -254,6 +281,31 @@ func (s *PaginatorPostgres) TestFirstPage() {
 	s.Require().Equal(11, page.PageTotalCount)
 }
 
+func (s *PaginatorPostgres) TestPageOf() {
+	var (
+		ctx = context.Background()
+	)
+
+	page, err := s.pag.Page(ctx, 3)
+
+	s.Require().NoError(err)
+
+	item := page.Data[4]
+
+	location, err := paginator.PageOf(ctx, s.pag, item.ID)
+
+	s.Require().NoError(err)
+
+	located, err := s.pag.Page(ctx, location.Page)
+
+	s.Require().NoError(err)
+	s.Require().Equal(item.Int_Field, located.Data[location.Index].Int_Field)
+
+	_, err = paginator.PageOf(ctx, s.pag, -1)
+
+	s.Require().ErrorIs(err, paginator.ErrItemNotFound)
+}
+
 func BenchmarkPaginatorPostgres(b *testing.B) {
 	sqlDB, cleanup, err := connectToDatabase()
 	if err != nil {
@@ -0,0 +1,201 @@
+package paginator_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+type prefetchEvent struct {
+	Page int
+	Used bool
+}
+
+type prefetchRecorder struct {
+	mtx    sync.Mutex
+	events []prefetchEvent
+}
+
+func (r *prefetchRecorder) Hook(page int, used bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.events = append(r.events, prefetchEvent{Page: page, Used: used})
+}
+
+func (r *prefetchRecorder) Events() []prefetchEvent {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return append([]prefetchEvent(nil), r.events...)
+}
+
+type prefetchQueryer struct {
+	Data    []int
+	Fail    atomic.Bool
+	Queries atomic.Int32
+}
+
+func (q *prefetchQueryer) Query(ctx context.Context, offset int, limit int) ([]int, error) {
+	fail := q.Fail.Load()
+
+	q.Queries.Add(1)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if fail {
+		return nil, errQueryFailed
+	}
+
+	return q.Data[offset : offset+limit], nil
+}
+
+func (q *prefetchQueryer) Count(ctx context.Context) (int, error) {
+	return len(q.Data), nil
+}
+
+func TestPrefetchUsed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer  = &prefetchQueryer{Data: makeFetchAllData(12)}
+		recorder = &prefetchRecorder{}
+		pag      = paginator.New(queryer, 5,
+			paginator.WithPrefetch(2, time.Minute),
+			paginator.WithPrefetchHook(recorder.Hook),
+		)
+		ctx, cancel = context.WithCancel(t.Context())
+	)
+
+	page, err := pag.Page(ctx, 1)
+
+	cancel()
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, page.Data)
+
+	page, err = pag.Page(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{6, 7, 8, 9, 10}, page.Data)
+
+	page, err = pag.Page(t.Context(), 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12}, page.Data)
+
+	require.Equal(t, []prefetchEvent{
+		{Page: 2, Used: true},
+		{Page: 3, Used: true},
+	}, recorder.Events())
+	require.EqualValues(t, 3, queryer.Queries.Load())
+}
+
+func TestPrefetchWasted(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer  = &prefetchQueryer{Data: makeFetchAllData(12)}
+		recorder = &prefetchRecorder{}
+		pag      = paginator.New(queryer, 5,
+			paginator.WithPrefetch(1, 10*time.Millisecond),
+			paginator.WithPrefetchHook(recorder.Hook),
+		)
+	)
+
+	_, err := pag.Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(recorder.Events()) == 1
+	}, time.Second, time.Millisecond)
+
+	require.Equal(t, []prefetchEvent{
+		{Page: 2, Used: false},
+	}, recorder.Events())
+	require.EqualValues(t, 2, queryer.Queries.Load())
+
+	page, err := pag.Page(t.Context(), 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12}, page.Data)
+	require.Len(t, recorder.Events(), 1)
+}
+
+func TestPrefetchFailedFallback(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer  = &prefetchQueryer{Data: makeFetchAllData(12)}
+		recorder = &prefetchRecorder{}
+		pag      = paginator.New(queryer, 5,
+			paginator.WithPrefetch(1, time.Minute),
+			paginator.WithPrefetchHook(recorder.Hook),
+		)
+	)
+
+	_, err := pag.Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	queryer.Fail.Store(true)
+
+	require.Eventually(t, func() bool {
+		return queryer.Queries.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	queryer.Fail.Store(false)
+
+	page, err := pag.Page(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{6, 7, 8, 9, 10}, page.Data)
+	require.Equal(t, prefetchEvent{Page: 2, Used: false}, recorder.Events()[0])
+}
+
+func TestPrefetchInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	for name, opt := range map[string]paginator.Option{
+		"negative limit": paginator.WithPrefetch(-1, time.Second),
+		"zero ttl":       paginator.WithPrefetch(1, 0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pag, err := paginator.NewWithOptions(&prefetchQueryer{}, opt)
+
+			require.ErrorIs(t, err, paginator.ErrInvalidOptions)
+			require.Nil(t, pag)
+		})
+	}
+}
+
+func TestPrefetchDisabledWithoutCount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		queryer  = &prefetchQueryer{Data: makeFetchAllData(12)}
+		recorder = &prefetchRecorder{}
+		pag      = paginator.New(queryer, 5,
+			paginator.WithoutCount(),
+			paginator.WithPrefetch(1, time.Minute),
+			paginator.WithPrefetchHook(recorder.Hook),
+		)
+	)
+
+	_, err := pag.Page(t.Context(), 1)
+
+	require.NoError(t, err)
+	require.EqualValues(t, 1, queryer.Queries.Load())
+	require.Empty(t, recorder.Events())
+}
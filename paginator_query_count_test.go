@@ -0,0 +1,130 @@
+package paginator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+)
+
+type mockQueryCounter struct {
+	*mock.MockQueryer[int]
+	*mock.MockQueryCounter[int]
+}
+
+func initMockQueryCounterPaginator(
+	t *testing.T,
+	opts ...paginator.Option,
+) (*paginator.Paginator[int], *mockQueryCounter) {
+	t.Helper()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = &mockQueryCounter{
+			MockQueryer:      mock.NewMockQueryer[int](ctrl),
+			MockQueryCounter: mock.NewMockQueryCounter[int](ctrl),
+		}
+	)
+
+	return paginator.New(mockQueryer, 3, opts...), mockQueryer
+}
+
+func TestQueryCountFirstPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 0, 3).Return([]int{1, 2, 3}, 7, nil)
+
+	page, err := pag.Page(ctx, 1)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{1, 2, 3}, page.Data)
+	require.Equal(t, 1, page.BottomIndex)
+	require.Equal(t, 3, page.TopIndex)
+	require.Equal(t, 3, page.PageSize)
+	require.Equal(t, 1, page.PageNumber)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func TestQueryCountLastPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 6, 3).Return([]int{7}, 7, nil)
+
+	page, err := pag.Page(ctx, 3)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{7}, page.Data)
+	require.Equal(t, 7, page.BottomIndex)
+	require.Equal(t, 7, page.TopIndex)
+	require.Equal(t, 1, page.PageSize)
+	require.Equal(t, 3, page.PageNumber)
+	require.Equal(t, 3, page.PageTotalCount)
+}
+
+func TestQueryCountBigPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 9, 3).Return(nil, 0, nil),
+		mockQueryer.MockQueryer.EXPECT().Count(ctx).Return(7, nil),
+	)
+
+	page, err := pag.Page(ctx, 4)
+
+	require.EqualError(t, err, "invalid page: 4 total pages: 3")
+	require.Nil(t, page)
+}
+
+func TestQueryCountEmpty(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 0, 3).Return(nil, 0, nil)
+
+	page, err := pag.Page(ctx, 1)
+
+	require.NoError(t, err)
+	require.Empty(t, page.Data)
+	require.Equal(t, 0, page.PageTotalCount)
+}
+
+func TestQueryCountError(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 0, 3).Return(nil, 0, errors.New("some query count error"))
+
+	page, err := pag.Page(ctx, 1)
+
+	require.EqualError(t, err, "query data and count: some query count error")
+	require.Nil(t, page)
+}
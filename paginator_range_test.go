@@ -0,0 +1,351 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize)
+		ctx         = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(20, nil),
+		mockQueryer.EXPECT().Query(ctx, 7, 4).Return([]int{8, 9, 10, 11}, nil),
+	)
+
+	window, err := pag.Range(ctx, 7, 4)
+
+	require.NoError(t, err)
+	require.Equal(t, &paginator.Window[int]{
+		Data:           []int{8, 9, 10, 11},
+		Offset:         7,
+		Limit:          4,
+		BottomIndex:    8,
+		TopIndex:       11,
+		ItemTotalCount: 20,
+	}, window)
+	require.True(t, window.HasNext())
+}
+
+func TestRangeLastItems(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize, paginator.WithPageSizeBounds(1, 20))
+		ctx         = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Count(ctx).Return(30, nil),
+		mockQueryer.EXPECT().Query(ctx, 25, 5).Return([]int{26, 27, 28, 29, 30}, nil),
+	)
+
+	window, err := pag.Range(ctx, 25, 100)
+
+	require.NoError(t, err)
+	require.Equal(t, 5, window.Limit)
+	require.Equal(t, 30, window.TopIndex)
+	require.False(t, window.HasNext())
+	require.False(t, window.Inconsistent)
+}
+
+func TestRangeInvalid(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize)
+	)
+
+	for name, args := range map[string][2]int{
+		"negative offset": {-1, 5},
+		"zero limit":      {0, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := pag.Range(t.Context(), args[0], args[1])
+
+			require.ErrorIs(t, err, paginator.ErrInvalidRange)
+			require.Nil(t, window)
+		})
+	}
+}
+
+func TestRangeEmptyCollection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize)
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(ctx).Return(0, nil)
+
+	window, err := pag.Range(ctx, 0, 5)
+
+	require.NoError(t, err)
+	require.True(t, window.IsEmpty())
+	require.False(t, window.HasNext())
+}
+
+func TestRangeOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Policy         paginator.OutOfRangePolicy
+		ExpectedWindow *paginator.Window[int]
+		ExpectedErr    bool
+	}{
+		"error": {
+			Policy:      paginator.OutOfRangeError,
+			ExpectedErr: true,
+		},
+		"clamp": {
+			Policy: paginator.OutOfRangeClamp,
+			ExpectedWindow: &paginator.Window[int]{
+				Data:           []int{9, 10, 11, 12},
+				Offset:         8,
+				Limit:          4,
+				BottomIndex:    9,
+				TopIndex:       12,
+				ItemTotalCount: 12,
+			},
+		},
+		"empty": {
+			Policy: paginator.OutOfRangeEmpty,
+			ExpectedWindow: &paginator.Window[int]{
+				Offset:         15,
+				ItemTotalCount: 12,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl        = gomock.NewController(t)
+				mockQueryer = mock.NewMockQueryer[int](ctrl)
+				pag         = paginator.New(mockQueryer, pageSize, paginator.WithOutOfRangePolicy(tc.Policy))
+				ctx         = t.Context()
+			)
+
+			mockQueryer.EXPECT().Count(ctx).Return(12, nil)
+			mockQueryer.EXPECT().Query(ctx, 8, 4).Return([]int{9, 10, 11, 12}, nil).AnyTimes()
+
+			window, err := pag.Range(ctx, 15, 4)
+
+			if tc.ExpectedErr {
+				var rangeErr *paginator.RangeError
+
+				require.ErrorAs(t, err, &rangeErr)
+				require.ErrorIs(t, err, paginator.ErrPageOutOfRange)
+				require.Equal(t, 12, rangeErr.ItemTotalCount)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedWindow, window)
+		})
+	}
+}
+
+func TestRangeWithoutCount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, pageSize, paginator.WithoutCount())
+		ctx         = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.EXPECT().Query(ctx, 3, 4).Return([]int{4, 5, 6, 7}, nil),
+		mockQueryer.EXPECT().Query(ctx, 10, 4).Return([]int{11, 12}, nil),
+		mockQueryer.EXPECT().Query(ctx, 20, 4).Return(nil, nil),
+	)
+
+	window, err := pag.Range(ctx, 3, 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5, 6}, window.Data)
+	require.True(t, window.TotalUnknown)
+	require.True(t, window.HasNext())
+
+	window, err = pag.Range(ctx, 10, 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12}, window.Data)
+	require.Equal(t, 12, window.ItemTotalCount)
+	require.False(t, window.HasNext())
+
+	window, err = pag.Range(ctx, 20, 3)
+
+	var rangeErr *paginator.RangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.True(t, rangeErr.TotalUnknown)
+	require.Nil(t, window)
+}
+
+func TestRangeWithEstimate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag = initEstimatePaginator(12, 100, pageSize)
+		ctx = t.Context()
+	)
+
+	window, err := pag.Range(ctx, 3, 4)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5, 6, 7}, window.Data)
+	require.Equal(t, 100, window.ItemTotalCount)
+	require.True(t, window.TotalIsEstimate)
+	require.True(t, window.HasNext())
+
+	window, err = pag.Range(ctx, 10, 4)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12}, window.Data)
+	require.Equal(t, 12, window.ItemTotalCount)
+	require.False(t, window.TotalIsEstimate)
+	require.False(t, window.HasNext())
+
+	window, err = pag.Range(ctx, 20, 4)
+
+	require.NoError(t, err)
+	require.True(t, window.IsEmpty())
+	require.Equal(t, 20, window.ItemTotalCount)
+	require.True(t, window.TotalIsEstimate)
+}
+
+func TestRangeWithQueryCount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 5, 3).Return([]int{6, 7}, 7, nil)
+
+	window, err := pag.Range(ctx, 5, 3)
+
+	require.NoError(t, err)
+	require.Equal(t, &paginator.Window[int]{
+		Data:           []int{6, 7},
+		Offset:         5,
+		Limit:          2,
+		BottomIndex:    6,
+		TopIndex:       7,
+		ItemTotalCount: 7,
+	}, window)
+}
+
+func TestRangeWithQueryCountOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag, mockQueryer = initMockQueryCounterPaginator(t)
+		ctx              = t.Context()
+	)
+
+	gomock.InOrder(
+		mockQueryer.MockQueryCounter.EXPECT().QueryCount(ctx, 9, 3).Return(nil, 0, nil),
+		mockQueryer.MockQueryer.EXPECT().Count(ctx).Return(7, nil),
+	)
+
+	window, err := pag.Range(ctx, 9, 3)
+
+	var rangeErr *paginator.RangeError
+
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, 7, rangeErr.ItemTotalCount)
+	require.Nil(t, window)
+}
+
+func TestRangeConcurrentQuery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3, paginator.WithConcurrentQuery())
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Count(gomock.Any()).Return(7, nil)
+	mockQueryer.EXPECT().Query(gomock.Any(), 5, 3).Return([]int{6, 7, 8}, nil)
+
+	window, err := pag.Range(ctx, 5, 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []int{6, 7}, window.Data)
+	require.Equal(t, 2, window.Limit)
+	require.Equal(t, 7, window.ItemTotalCount)
+	require.False(t, window.HasNext())
+}
+
+func TestRangeMatchesPage(t *testing.T) {
+	t.Parallel()
+
+	for name, init := range map[string]func(queryer *fetchAllQueryer) *paginator.Paginator[int]{
+		"count": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(queryer, 5)
+		},
+		"without count": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(queryer, 5, paginator.WithoutCount())
+		},
+		"estimate": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(&estimatingFetchAllQueryer{fetchAllQueryer: queryer}, 5)
+		},
+		"concurrent": func(queryer *fetchAllQueryer) *paginator.Paginator[int] {
+			return paginator.New(queryer, 5, paginator.WithConcurrentQuery())
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				pag = init(&fetchAllQueryer{Data: makeFetchAllData(12)})
+				ctx = t.Context()
+			)
+
+			for number := 1; number <= 3; number++ {
+				page, err := pag.Page(ctx, number)
+
+				require.NoError(t, err)
+
+				window, err := pag.Range(ctx, page.Offset, 5)
+
+				require.NoError(t, err)
+				require.Equal(t, page.Data, window.Data)
+				require.Equal(t, page.PageSize, window.Limit)
+				require.Equal(t, page.ItemTotalCount, window.ItemTotalCount)
+				require.Equal(t, page.TotalUnknown, window.TotalUnknown)
+				require.Equal(t, page.TotalIsEstimate, window.TotalIsEstimate)
+				require.Equal(t, page.HasNext(), window.HasNext())
+			}
+		})
+	}
+}
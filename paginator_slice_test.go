@@ -184,11 +184,16 @@ func TestZeroSlice(t *testing.T) {
 		require.NoError(t, err)
 
 		require.ElementsMatch(t, nil, page.Data)
+		require.Equal(t, 0, page.Offset)
 		require.Equal(t, 0, page.BottomIndex)
 		require.Equal(t, 0, page.TopIndex)
 		require.Equal(t, 0, page.PageSize)
-		require.Equal(t, 0, page.PageNumber)
+		require.Equal(t, 1, page.PageNumber)
 		require.Equal(t, 0, page.PageTotalCount)
+		require.Equal(t, 0, page.ItemTotalCount)
+		require.True(t, page.IsEmpty())
+		require.True(t, page.IsFirst())
+		require.True(t, page.IsLast())
 	}
 
 	t.Run("inplace", func(t *testing.T) {
@@ -210,7 +215,7 @@ func TestInvalidPageZeroPage(t *testing.T) {
 
 		page, err := pg.Page(t.Context(), 0)
 
-		require.EqualError(t, err, "invaid page number: 0")
+		require.EqualError(t, err, "invalid page number: 0")
 		require.Nil(t, page)
 	}
 
@@ -233,7 +238,7 @@ func TestInvalidPageNegativePage(t *testing.T) {
 
 		page, err := pg.Page(t.Context(), -1)
 
-		require.EqualError(t, err, "invaid page number: -1")
+		require.EqualError(t, err, "invalid page number: -1")
 		require.Nil(t, page)
 	}
 
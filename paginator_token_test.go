@@ -0,0 +1,111 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryerslice"
+	"github.com/Mikhalevich/paginator/token"
+)
+
+func initTokenPaginator(t *testing.T, dataLen int) *paginator.Paginator[int] {
+	t.Helper()
+
+	codec, err := token.NewCodec([]byte("secret"))
+
+	require.NoError(t, err)
+
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.New(queryerslice.New(data), 10, paginator.WithTokenCodec(codec))
+}
+
+func TestPageByToken(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pag        = initTokenPaginator(t, 25)
+		ctx        = t.Context()
+		filterHash = token.HashFilter("open")
+	)
+
+	page, err := pag.PageByToken(ctx, "", filterHash)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, page.PageNumber)
+	require.NotEmpty(t, page.NextPageToken)
+	require.Empty(t, page.PreviousPageToken)
+
+	page, err = pag.PageByToken(ctx, page.NextPageToken, filterHash)
+
+	require.NoError(t, err)
+	require.Equal(t, 2, page.PageNumber)
+	require.Equal(t, []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, page.Data)
+	require.NotEmpty(t, page.NextPageToken)
+	require.NotEmpty(t, page.PreviousPageToken)
+
+	page, err = pag.PageByToken(ctx, page.NextPageToken, filterHash)
+
+	require.NoError(t, err)
+	require.Equal(t, 3, page.PageNumber)
+	require.Empty(t, page.NextPageToken)
+
+	_, err = pag.PageByToken(ctx, page.PreviousPageToken, token.HashFilter("closed"))
+
+	require.ErrorIs(t, err, token.ErrFilterMismatch)
+}
+
+func TestPageByTokenInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := initTokenPaginator(t, 25).PageByToken(t.Context(), "invalid", "")
+
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+}
+
+func TestPageByTokenWithoutCodec(t *testing.T) {
+	t.Parallel()
+
+	_, err := initSlicePaginator(25, 10).PageByToken(t.Context(), "", "")
+
+	require.ErrorIs(t, err, paginator.ErrNoTokenCodec)
+}
+
+func TestCursorPageByToken(t *testing.T) {
+	t.Parallel()
+
+	codec, err := token.NewCodec([]byte("secret"), token.WithEncryption([]byte("0123456789abcdef")))
+
+	require.NoError(t, err)
+
+	data := make([]int, 0, 25)
+	for i := range 25 {
+		data = append(data, i+1)
+	}
+
+	var (
+		pag = paginator.NewCursor(&sliceCursorQueryer{data: data}, 10, paginator.WithCursorTokenCodec(codec))
+		ctx = t.Context()
+	)
+
+	page, err := pag.PageByToken(ctx, "", "")
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, page.Data)
+	require.Empty(t, page.PreviousPageToken)
+
+	page, err = pag.PageByToken(ctx, page.NextPageToken, "")
+
+	require.NoError(t, err)
+	require.Equal(t, []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, page.Data)
+
+	page, err = pag.PageByToken(ctx, page.PreviousPageToken, "")
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, page.Data)
+}
@@ -0,0 +1,113 @@
+package paginator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/mock"
+	"github.com/Mikhalevich/paginator/queryerslice"
+)
+
+func initWithoutCountPaginator(dataLen, pageSize int) *paginator.Paginator[int] {
+	data := make([]int, 0, dataLen)
+	for i := range dataLen {
+		data = append(data, i+1)
+	}
+
+	return paginator.New(queryerslice.New(data), pageSize, paginator.WithoutCount())
+}
+
+func TestWithoutCountFirstPage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctrl        = gomock.NewController(t)
+		mockQueryer = mock.NewMockQueryer[int](ctrl)
+		pag         = paginator.New(mockQueryer, 3, paginator.WithoutCount())
+		ctx         = t.Context()
+	)
+
+	mockQueryer.EXPECT().Query(ctx, 0, 4).Return([]int{1, 2, 3, 4}, nil)
+
+	page, err := pag.Page(ctx, 1)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{1, 2, 3}, page.Data)
+	require.Equal(t, 1, page.BottomIndex)
+	require.Equal(t, 3, page.TopIndex)
+	require.Equal(t, 3, page.PageSize)
+	require.Equal(t, 1, page.PageNumber)
+	require.Equal(t, 0, page.PageTotalCount)
+	require.True(t, page.TotalUnknown)
+	require.True(t, page.HasNext())
+	require.Equal(t, 2, page.Next())
+}
+
+func TestWithoutCountLastPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initWithoutCountPaginator(101, 10).Page(t.Context(), 11)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []int{101}, page.Data)
+	require.Equal(t, 101, page.BottomIndex)
+	require.Equal(t, 101, page.TopIndex)
+	require.Equal(t, 1, page.PageSize)
+	require.Equal(t, 11, page.PageNumber)
+	require.Equal(t, 11, page.PageTotalCount)
+	require.False(t, page.TotalUnknown)
+	require.False(t, page.HasNext())
+}
+
+func TestWithoutCountFullLastPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initWithoutCountPaginator(100, 10).Page(t.Context(), 10)
+
+	require.NoError(t, err)
+
+	require.Len(t, page.Data, 10)
+	require.Equal(t, 10, page.PageTotalCount)
+	require.False(t, page.TotalUnknown)
+	require.False(t, page.HasNext())
+}
+
+func TestWithoutCountEmpty(t *testing.T) {
+	t.Parallel()
+
+	page, err := initWithoutCountPaginator(0, 10).Page(t.Context(), 1)
+
+	require.NoError(t, err)
+
+	require.Empty(t, page.Data)
+	require.False(t, page.TotalUnknown)
+	require.False(t, page.HasNext())
+}
+
+func TestWithoutCountInvalidBigPage(t *testing.T) {
+	t.Parallel()
+
+	page, err := initWithoutCountPaginator(101, 10).Page(t.Context(), 12)
+
+	require.EqualError(t, err, "invalid page: 12 no data")
+	require.Nil(t, page)
+}
+
+func TestWithoutCountItems(t *testing.T) {
+	t.Parallel()
+
+	var items []int
+
+	for item, err := range initWithoutCountPaginator(25, 10).Items(t.Context()) {
+		require.NoError(t, err)
+
+		items = append(items, item)
+	}
+
+	require.Len(t, items, 25)
+}
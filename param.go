@@ -0,0 +1,83 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+)
+
+// ParamPaginator paginates ParamQueryer passing params of each call through to the queryer.
+type ParamPaginator[T any, P any] struct {
+	paginator *Paginator[T]
+	queryer   ParamQueryer[T, P]
+}
+
+// NewParam construct param paginator.
+// panics on invalid options, use NewParamWithOptions for error handling.
+func NewParam[T any, P any](queryer ParamQueryer[T, P], pageSize int, opts ...Option) *ParamPaginator[T, P] {
+	paginator, err := NewParamWithOptions(queryer, append([]Option{WithPageSize(pageSize)}, opts...)...)
+	if err != nil {
+		panic(err)
+	}
+
+	return paginator
+}
+
+// NewParamWithOptions construct param paginator and validates options.
+func NewParamWithOptions[T any, P any](queryer ParamQueryer[T, P], opts ...Option) (*ParamPaginator[T, P], error) {
+	paginator, err := NewWithOptions[T](nil, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new paginator: %w", err)
+	}
+
+	return &ParamPaginator[T, P]{
+		paginator: paginator,
+		queryer:   queryer,
+	}, nil
+}
+
+// Bind returns paginator with params bound to every queryer call.
+// returned paginator shares options with the param paginator.
+func (p *ParamPaginator[T, P]) Bind(params P) *Paginator[T] {
+	return &Paginator[T]{
+		queryer: boundQueryer[T, P]{
+			queryer: p.queryer,
+			params:  params,
+		},
+		pageSize: p.paginator.pageSize,
+		opts:     p.paginator.opts,
+	}
+}
+
+// Page returns information about page by it's number for specified params.
+func (p *ParamPaginator[T, P]) Page(ctx context.Context, params P, page int) (*Page[T], error) {
+	return p.Bind(params).Page(ctx, page)
+}
+
+// PageWithSize returns information about page by it's number for specified params overriding page size.
+func (p *ParamPaginator[T, P]) PageWithSize(ctx context.Context, params P, page int, size int) (*Page[T], error) {
+	return p.Bind(params).PageWithSize(ctx, page, size)
+}
+
+// boundQueryer adapts ParamQueryer to Queryer with fixed params.
+type boundQueryer[T any, P any] struct {
+	queryer ParamQueryer[T, P]
+	params  P
+}
+
+func (b boundQueryer[T, P]) Query(ctx context.Context, offset int, limit int) ([]T, error) {
+	data, err := b.queryer.Query(ctx, b.params, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	return data, nil
+}
+
+func (b boundQueryer[T, P]) Count(ctx context.Context) (int, error) {
+	count, err := b.queryer.Count(ctx, b.params)
+	if err != nil {
+		return 0, fmt.Errorf("count: %w", err)
+	}
+
+	return count, nil
+}
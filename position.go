@@ -0,0 +1,39 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+)
+
+// ItemLocation describes page containing item and item index within page data.
+type ItemLocation struct {
+	Page  int
+	Index int
+}
+
+// PageOf returns location of item with key using paginator default page size.
+// paginator queryer should implement PositionQueryer with the same key type,
+// decorated queryers should pass Position through (e.g. queryercache.NewPosition).
+func PageOf[T any, K any](ctx context.Context, p *Paginator[T], key K) (ItemLocation, error) {
+	positioner, ok := p.queryer.(PositionQueryer[T, K])
+	if !ok {
+		return ItemLocation{}, fmt.Errorf("%w: %T", ErrPositionNotSupported, p.queryer)
+	}
+
+	position, found, err := positioner.Position(ctx, key)
+	if err != nil {
+		return ItemLocation{}, &QueryError{
+			Op:  "query position",
+			Err: err,
+		}
+	}
+
+	if !found {
+		return ItemLocation{}, fmt.Errorf("%w: %v", ErrItemNotFound, key)
+	}
+
+	return ItemLocation{
+		Page:  position/p.pageSize + 1,
+		Index: position % p.pageSize,
+	}, nil
+}
@@ -0,0 +1,208 @@
+package paginator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// prefetchKey identifies prefetched query.
+type prefetchKey struct {
+	Offset int
+	Limit  int
+}
+
+// prefetchEntry holds prefetched data available after Ready is closed.
+// Timer expires entry after ttl unless it is taken before.
+type prefetchEntry[T any] struct {
+	Page  int
+	Data  []T
+	Err   error
+	Ready chan struct{}
+	Timer *time.Timer
+}
+
+// prefetcher stores next page data fetched in background.
+type prefetcher[T any] struct {
+	mtx     sync.Mutex
+	entries map[prefetchKey]*prefetchEntry[T]
+	limit   int
+	ttl     time.Duration
+	hook    func(page int, used bool)
+}
+
+func newPrefetcher[T any](limit int, ttl time.Duration, hook func(page int, used bool)) *prefetcher[T] {
+	if hook == nil {
+		hook = func(page int, used bool) {}
+	}
+
+	return &prefetcher[T]{
+		entries: make(map[prefetchKey]*prefetchEntry[T], limit),
+		limit:   limit,
+		ttl:     ttl,
+		hook:    hook,
+	}
+}
+
+// start runs query in background unless it is already prefetched or limit is reached.
+// query context keeps ctx values but not its cancellation and is limited by ttl,
+// entry not taken within ttl is dropped and reported as wasted.
+func (p *prefetcher[T]) start(ctx context.Context, queryer Queryer[T], page int, key prefetchKey) {
+	entry, ok := p.add(page, key)
+	if !ok {
+		return
+	}
+
+	go func() {
+		defer close(entry.Ready)
+
+		queryCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.ttl)
+		defer cancel()
+
+		entry.Data, entry.Err = queryer.Query(queryCtx, key.Offset, key.Limit)
+	}()
+}
+
+func (p *prefetcher[T]) add(page int, key prefetchKey) (*prefetchEntry[T], bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.entries[key]; ok || len(p.entries) >= p.limit {
+		return nil, false
+	}
+
+	entry := &prefetchEntry[T]{
+		Page:  page,
+		Ready: make(chan struct{}),
+	}
+
+	entry.Timer = time.AfterFunc(p.ttl, func() {
+		p.expire(key, entry)
+	})
+
+	p.entries[key] = entry
+
+	return entry, true
+}
+
+// expire drops entry if it is still stored and reports it as wasted.
+func (p *prefetcher[T]) expire(key prefetchKey, entry *prefetchEntry[T]) {
+	if p.drop(key, entry) {
+		p.hook(entry.Page, false)
+	}
+}
+
+// drop removes entry stored with key and reports whether it was stored.
+func (p *prefetcher[T]) drop(key prefetchKey, entry *prefetchEntry[T]) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.entries[key] != entry {
+		return false
+	}
+
+	delete(p.entries, key)
+
+	return true
+}
+
+// take returns prefetched data waiting for query in flight.
+// returns false if data is not prefetched, prefetch failed or ctx is done.
+func (p *prefetcher[T]) take(ctx context.Context, key prefetchKey) ([]T, bool) {
+	entry, ok := p.remove(key)
+	if !ok {
+		return nil, false
+	}
+
+	select {
+	case <-ctx.Done():
+		p.hook(entry.Page, false)
+
+		return nil, false
+	case <-entry.Ready:
+	}
+
+	if entry.Err != nil {
+		p.hook(entry.Page, false)
+
+		return nil, false
+	}
+
+	p.hook(entry.Page, true)
+
+	return entry.Data, true
+}
+
+func (p *prefetcher[T]) remove(key prefetchKey) (*prefetchEntry[T], bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	entry, ok := p.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry.Timer.Stop()
+	delete(p.entries, key)
+
+	return entry, true
+}
+
+// query returns prefetched data if available, otherwise queries data.
+func (p *Paginator[T]) query(ctx context.Context, offset int, limit int) ([]T, error) {
+	if p.prefetch != nil {
+		if data, ok := p.prefetch.take(ctx, prefetchKey{
+			Offset: offset,
+			Limit:  limit,
+		}); ok {
+			return data, nil
+		}
+	}
+
+	data, err := p.queryer.Query(ctx, offset, limit)
+	if err != nil {
+		return nil, &QueryError{
+			Op:  "query data",
+			Err: err,
+		}
+	}
+
+	return data, nil
+}
+
+// prefetchNext starts background query for the page following served page.
+func (p *Paginator[T]) prefetchNext(ctx context.Context, req pageRequest, page *Page[T]) {
+	if p.prefetch == nil || !p.countMode() || !page.HasNext() {
+		return
+	}
+
+	var (
+		next                         = pageRequest{Number: page.PageNumber + 1, Size: req.Size}
+		limit                        = next.Size
+		pageTotalCount, lastPageSize = calculatePageCountAndLastPageSize(page.ItemTotalCount, next.Size)
+	)
+
+	if next.Number == pageTotalCount {
+		limit = lastPageSize
+	}
+
+	p.prefetch.start(ctx, p.queryer, next.Number, prefetchKey{
+		Offset: next.Offset(),
+		Limit:  limit,
+	})
+}
+
+// countMode reports whether pages are fetched with separate Count and Query calls.
+func (p *Paginator[T]) countMode() bool {
+	if p.opts.WithoutCount || p.opts.ConcurrentQuery {
+		return false
+	}
+
+	if _, ok := p.queryer.(EstimatingQueryer); ok {
+		return false
+	}
+
+	_, ok := p.queryer.(QueryCounter[T])
+
+	return !ok
+}
@@ -0,0 +1,139 @@
+package queryercache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryercache/metrics"
+)
+
+// ErrUnsupportedKey is returned by NewCursor for cursor key type unusable as cache key.
+var ErrUnsupportedKey = errors.New("unsupported key type")
+
+// CursorQueryerCache implementing cache for paginator.CursorQueryer interface.
+// cursor keys are part of the cache key and formatted with %#v.
+type CursorQueryerCache[T any, K any] struct {
+	queryer paginator.CursorQueryer[T, K]
+
+	query    keyValue[[]T]
+	queryMtx sync.RWMutex
+
+	metrics CacheMetrics
+}
+
+// NewCursor constructs new CursorQueryerCache.
+// WithCountTTL option is ignored since cursor queryer has no count.
+// returns ErrUnsupportedKey if key type contains pointers (except time.Time),
+// interfaces, funcs or channels, since their formatted value doesn't reflect the pointed data.
+func NewCursor[T any, K any](
+	queryer paginator.CursorQueryer[T, K],
+	opts ...Option,
+) (*CursorQueryerCache[T, K], error) {
+	if typ := reflect.TypeFor[K](); !plainType(typ, make(map[reflect.Type]bool)) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKey, typ)
+	}
+
+	defaultOptions := options{
+		QueryTTL: defaultQueryCacheTTL,
+		Metrics:  metrics.NewNoop(),
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	return &CursorQueryerCache[T, K]{
+		queryer: queryer,
+		query:   newKeyValue[[]T](defaultOptions.QueryTTL),
+		metrics: defaultOptions.Metrics,
+	}, nil
+}
+
+// QueryAfter returns cached data value if available and not expired.
+// otherwise returns value from queryer.QueryAfter and update cache value.
+func (q *CursorQueryerCache[T, K]) QueryAfter(ctx context.Context, key *K, limit int) ([]T, error) {
+	vals, cached, err := q.queryValueAndUpdateCache(
+		makeCursorQueryKey("after", key, limit),
+		func() ([]T, error) {
+			return q.queryer.QueryAfter(ctx, key, limit)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query after value and update cache: %w", err)
+	}
+
+	q.metrics.QueryIncrement(cached)
+
+	return vals, nil
+}
+
+// QueryBefore returns cached data value if available and not expired.
+// otherwise returns value from queryer.QueryBefore and update cache value.
+func (q *CursorQueryerCache[T, K]) QueryBefore(ctx context.Context, key K, limit int) ([]T, error) {
+	vals, cached, err := q.queryValueAndUpdateCache(
+		makeCursorQueryKey("before", &key, limit),
+		func() ([]T, error) {
+			return q.queryer.QueryBefore(ctx, key, limit)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query before value and update cache: %w", err)
+	}
+
+	q.metrics.QueryIncrement(cached)
+
+	return vals, nil
+}
+
+// Key returns key from underlying queryer.
+func (q *CursorQueryerCache[T, K]) Key(item T) K {
+	return q.queryer.Key(item)
+}
+
+// queryValueAndUpdateCache returns query value and flag specified is it from cache or not.
+// call query func and update cache value if cache is expired.
+func (q *CursorQueryerCache[T, K]) queryValueAndUpdateCache(
+	key string,
+	query func() ([]T, error),
+) ([]T, bool, error) {
+	vals, ok := q.queryValue(key)
+	if ok {
+		return vals, true, nil
+	}
+
+	vals, err := query()
+	if err != nil {
+		return nil, false, fmt.Errorf("query: %w", err)
+	}
+
+	q.setQueryValue(key, vals)
+
+	return vals, false, nil
+}
+
+// queryValue returns query cache value and expiration flag.
+func (q *CursorQueryerCache[T, K]) queryValue(key string) ([]T, bool) {
+	q.queryMtx.RLock()
+	defer q.queryMtx.RUnlock()
+
+	return q.query.Value(key)
+}
+
+func (q *CursorQueryerCache[T, K]) setQueryValue(key string, vals []T) {
+	q.queryMtx.Lock()
+	defer q.queryMtx.Unlock()
+
+	q.query.SetValue(key, vals)
+}
+
+func makeCursorQueryKey[K any](direction string, key *K, limit int) string {
+	if key == nil {
+		return fmt.Sprintf("%s_%d", direction, limit)
+	}
+
+	return fmt.Sprintf("%s_%#v_%d", direction, *key, limit)
+}
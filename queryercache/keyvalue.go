@@ -4,15 +4,22 @@ import (
 	"time"
 )
 
+type keyValueEntry[T any] struct {
+	Val       T
+	ExpiresAt time.Time
+}
+
+// keyValue stores values with per key expiration.
+// expired values are pruned on SetValue at most once per TTL.
 type keyValue[T any] struct {
-	Val           map[string]T
+	Val           map[string]keyValueEntry[T]
 	TTL           time.Duration
-	LastAccesTime time.Time
+	LastPruneTime time.Time
 }
 
 func newKeyValue[T any](ttl time.Duration) keyValue[T] {
 	return keyValue[T]{
-		Val: make(map[string]T),
+		Val: make(map[string]keyValueEntry[T]),
 		TTL: ttl,
 	}
 }
@@ -24,15 +31,12 @@ func (v *keyValue[T]) Value(key string) (T, bool) {
 		return defaultVal, false
 	}
 
-	if time.Since(v.LastAccesTime) > v.TTL {
+	entry, ok := v.Val[key]
+	if !ok || !time.Now().Before(entry.ExpiresAt) {
 		return defaultVal, false
 	}
 
-	if val, ok := v.Val[key]; ok {
-		return val, true
-	}
-
-	return defaultVal, false
+	return entry.Val, true
 }
 
 func (v *keyValue[T]) SetValue(key string, val T) {
@@ -40,9 +44,24 @@ func (v *keyValue[T]) SetValue(key string, val T) {
 		return
 	}
 
-	v.Val[key] = val
+	now := time.Now()
+
+	if now.Sub(v.LastPruneTime) > v.TTL {
+		v.prune(now)
+	}
+
+	v.Val[key] = keyValueEntry[T]{
+		Val:       val,
+		ExpiresAt: now.Add(v.TTL),
+	}
+}
 
-	if time.Since(v.LastAccesTime) > v.TTL {
-		v.LastAccesTime = time.Now()
+func (v *keyValue[T]) prune(now time.Time) {
+	for key, entry := range v.Val {
+		if !now.Before(entry.ExpiresAt) {
+			delete(v.Val, key)
+		}
 	}
+
+	v.LastPruneTime = now
 }
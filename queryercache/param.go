@@ -0,0 +1,198 @@
+package queryercache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Mikhalevich/paginator"
+	"github.com/Mikhalevich/paginator/queryercache/metrics"
+)
+
+// ErrUnsupportedParams is returned by NewParam for params type unusable as cache key.
+var ErrUnsupportedParams = errors.New("unsupported params type")
+
+// ParamQueryerCache implementing cache for paginator.ParamQueryer interface.
+// params are part of the cache key and formatted with %#v,
+// every params value is cached separately and expires independently.
+type ParamQueryerCache[T any, P any] struct {
+	queryer paginator.ParamQueryer[T, P]
+
+	count    keyValue[int]
+	countMtx sync.RWMutex
+
+	query    keyValue[[]T]
+	queryMtx sync.RWMutex
+
+	metrics CacheMetrics
+}
+
+// NewParam constructs new ParamQueryerCache.
+// returns ErrUnsupportedParams if params type contains pointers (except time.Time),
+// interfaces, funcs or channels, since their formatted value doesn't reflect the pointed data.
+func NewParam[T any, P any](
+	queryer paginator.ParamQueryer[T, P],
+	opts ...Option,
+) (*ParamQueryerCache[T, P], error) {
+	if typ := reflect.TypeFor[P](); !plainType(typ, make(map[reflect.Type]bool)) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedParams, typ)
+	}
+
+	defaultOptions := options{
+		CountTTL: defaultCountCacheTTL,
+		QueryTTL: defaultQueryCacheTTL,
+		Metrics:  metrics.NewNoop(),
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	return &ParamQueryerCache[T, P]{
+		queryer: queryer,
+		count:   newKeyValue[int](defaultOptions.CountTTL),
+		query:   newKeyValue[[]T](defaultOptions.QueryTTL),
+		metrics: defaultOptions.Metrics,
+	}, nil
+}
+
+// plainType reports whether formatted value of type fully represents its data.
+func plainType(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return true
+	}
+
+	visited[typ] = true
+
+	if typ == reflect.TypeFor[time.Time]() {
+		return true
+	}
+
+	//nolint:exhaustive
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Interface, reflect.Func, reflect.Chan:
+		return false
+	case reflect.Array, reflect.Slice:
+		return plainType(typ.Elem(), visited)
+	case reflect.Map:
+		return plainType(typ.Key(), visited) && plainType(typ.Elem(), visited)
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			if !plainType(typ.Field(i).Type, visited) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Count returns count value for params from cache if available and not expired.
+// otherwise returns value from queryer.Count and update cache value.
+func (q *ParamQueryerCache[T, P]) Count(ctx context.Context, params P) (int, error) {
+	val, cached, err := q.countValueAndUpdateCache(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("count value and update cache: %w", err)
+	}
+
+	q.metrics.CountIncrement(cached)
+
+	return val, nil
+}
+
+// countValueAndUpdateCache returns count value and flag specified is it from cache or not.
+// call queryer.Count and update cache value if cache is expired.
+func (q *ParamQueryerCache[T, P]) countValueAndUpdateCache(ctx context.Context, params P) (int, bool, error) {
+	key := makeParamKey(params)
+
+	val, ok := q.countValue(key, true)
+	if ok {
+		return val, true, nil
+	}
+
+	q.countMtx.Lock()
+	defer q.countMtx.Unlock()
+
+	val, ok = q.countValue(key, false)
+	if ok {
+		return val, true, nil
+	}
+
+	count, err := q.queryer.Count(ctx, params)
+	if err != nil {
+		return 0, false, fmt.Errorf("count: %w", err)
+	}
+
+	q.count.SetValue(key, count)
+
+	return count, false, nil
+}
+
+func (q *ParamQueryerCache[T, P]) countValue(key string, withLock bool) (int, bool) {
+	if withLock {
+		q.countMtx.RLock()
+		defer q.countMtx.RUnlock()
+	}
+
+	return q.count.Value(key)
+}
+
+// Query returns cached data value for params if available and not expired.
+// otherwise returns value from queryer.Query and update cache value.
+func (q *ParamQueryerCache[T, P]) Query(ctx context.Context, params P, offset int, limit int) ([]T, error) {
+	val, cached, err := q.queryValueAndUpdateCache(ctx, params, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query value and update cache: %w", err)
+	}
+
+	q.metrics.QueryIncrement(cached)
+
+	return val, nil
+}
+
+// queryValueAndUpdateCache returns query value and flag specified is it from cache or not.
+// call queryer.Query and update cache value if cache is expired.
+func (q *ParamQueryerCache[T, P]) queryValueAndUpdateCache(
+	ctx context.Context,
+	params P,
+	offset int,
+	limit int,
+) ([]T, bool, error) {
+	key := makeParamKey(params) + "_" + makeQueryKey(offset, limit)
+
+	vals, ok := q.queryValue(key)
+	if ok {
+		return vals, true, nil
+	}
+
+	vals, err := q.queryer.Query(ctx, params, offset, limit)
+	if err != nil {
+		return nil, false, fmt.Errorf("query: %w", err)
+	}
+
+	q.setQueryValue(key, vals)
+
+	return vals, false, nil
+}
+
+// queryValue returns query cache value and expiration flag.
+func (q *ParamQueryerCache[T, P]) queryValue(key string) ([]T, bool) {
+	q.queryMtx.RLock()
+	defer q.queryMtx.RUnlock()
+
+	return q.query.Value(key)
+}
+
+func (q *ParamQueryerCache[T, P]) setQueryValue(key string, vals []T) {
+	q.queryMtx.Lock()
+	defer q.queryMtx.Unlock()
+
+	q.query.SetValue(key, vals)
+}
+
+func makeParamKey[P any](params P) string {
+	return fmt.Sprintf("%#v", params)
+}
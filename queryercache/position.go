@@ -0,0 +1,38 @@
+package queryercache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mikhalevich/paginator"
+)
+
+// PositionQueryerCache implementing cache for paginator.PositionQueryer interface.
+// Count and Query are cached the same way as for QueryerCache,
+// Position is forwarded to the wrapped queryer without caching.
+type PositionQueryerCache[T any, K any] struct {
+	*QueryerCache[T]
+
+	positioner paginator.PositionQueryer[T, K]
+}
+
+// NewPosition constructs new PositionQueryerCache.
+func NewPosition[T any, K any](
+	queryer paginator.PositionQueryer[T, K],
+	opts ...Option,
+) *PositionQueryerCache[T, K] {
+	return &PositionQueryerCache[T, K]{
+		QueryerCache: New(queryer, opts...),
+		positioner:   queryer,
+	}
+}
+
+// Position returns position of item with key from queryer.Position.
+func (q *PositionQueryerCache[T, K]) Position(ctx context.Context, key K) (int, bool, error) {
+	position, found, err := q.positioner.Position(ctx, key)
+	if err != nil {
+		return 0, false, fmt.Errorf("position: %w", err)
+	}
+
+	return position, found, nil
+}
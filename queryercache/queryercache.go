@@ -169,3 +169,10 @@ func (q *QueryerCache[T]) setQueryValue(key string, vals []T) {
 func makeQueryKey(offset int, limit int) string {
 	return fmt.Sprintf("%d_%d", offset, limit)
 }
+
+// Decorator returns paginator.Decorator wrapping queryer with QueryerCache.
+func Decorator[T any](opts ...Option) paginator.Decorator[T] {
+	return func(queryer paginator.Queryer[T]) paginator.Queryer[T] {
+		return New(queryer, opts...)
+	}
+}
@@ -0,0 +1,32 @@
+package queryerslice
+
+import (
+	"context"
+)
+
+// KeyedQueryerSlice implementation of paginator.PositionQueryer for slice data.
+type KeyedQueryerSlice[T any, K comparable] struct {
+	*QueryerSlice[T]
+
+	key func(item T) K
+}
+
+// NewKeyed construct new KeyedQueryerSlice.
+// key returns item key used by Position.
+func NewKeyed[T any, K comparable](data []T, key func(item T) K, opts ...Option) *KeyedQueryerSlice[T, K] {
+	return &KeyedQueryerSlice[T, K]{
+		QueryerSlice: New(data, opts...),
+		key:          key,
+	}
+}
+
+// Position returns index of the first item with key.
+func (s *KeyedQueryerSlice[T, K]) Position(ctx context.Context, key K) (int, bool, error) {
+	for i, item := range s.Data {
+		if s.key(item) == key {
+			return i, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
@@ -27,19 +27,23 @@ func New[T any](data []T, opts ...Option) *QueryerSlice[T] {
 }
 
 // Query returns subslice from base slice according offset and limit params.
+// offset and limit are clamped to the slice bounds.
 // if WithCopy option is specified returns copy of subslice.
 func (s *QueryerSlice[T]) Query(ctx context.Context, offset int, limit int) ([]T, error) {
-	endIndex := offset + limit
+	var (
+		startIndex = min(offset, len(s.Data))
+		endIndex   = min(offset+limit, len(s.Data))
+	)
 
 	if s.opts.CopySlice {
-		sliceCopy := make([]T, endIndex-offset)
+		sliceCopy := make([]T, endIndex-startIndex)
 
-		copy(sliceCopy, s.Data[offset:endIndex])
+		copy(sliceCopy, s.Data[startIndex:endIndex])
 
 		return sliceCopy, nil
 	}
 
-	return s.Data[offset:endIndex], nil
+	return s.Data[startIndex:endIndex], nil
 }
 
 // Count returns length of internal slice data.
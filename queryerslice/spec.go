@@ -0,0 +1,50 @@
+package queryerslice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mikhalevich/paginator/spec"
+)
+
+// SpecQueryer implementation of paginator.ParamQueryer for slice data filtered and sorted by spec.Spec.
+type SpecQueryer[T any] struct {
+	Data []T
+
+	fields spec.Fields[T]
+}
+
+// NewSpec construct new SpecQueryer.
+// fields maps spec fields to item accessors.
+func NewSpec[T any](data []T, fields spec.Fields[T]) *SpecQueryer[T] {
+	return &SpecQueryer[T]{
+		Data:   data,
+		fields: fields,
+	}
+}
+
+// Query returns subslice of data matching spec according offset and limit params.
+// offset and limit are clamped to the matching data bounds.
+func (s *SpecQueryer[T]) Query(ctx context.Context, params spec.Spec, offset int, limit int) ([]T, error) {
+	data, err := spec.Apply(s.Data, params, s.fields)
+	if err != nil {
+		return nil, fmt.Errorf("apply spec: %w", err)
+	}
+
+	var (
+		startIndex = min(offset, len(data))
+		endIndex   = min(offset+limit, len(data))
+	)
+
+	return data[startIndex:endIndex], nil
+}
+
+// Count returns length of data matching spec.
+func (s *SpecQueryer[T]) Count(ctx context.Context, params spec.Spec) (int, error) {
+	data, err := spec.Apply(s.Data, params, s.fields)
+	if err != nil {
+		return 0, fmt.Errorf("apply spec: %w", err)
+	}
+
+	return len(data), nil
+}
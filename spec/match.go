@@ -0,0 +1,273 @@
+package spec
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fields maps spec fields to item accessors for in-memory filtering and sorting.
+// accessor should return string, bool, time.Time (RFC3339 in filter values) or any integer or float value.
+type Fields[T any] map[string]func(item T) any
+
+// Apply returns items matching spec filters sorted by spec sort keys.
+// source slice is not modified.
+func Apply[T any](items []T, spec Spec, fields Fields[T]) ([]T, error) {
+	result := make([]T, 0, len(items))
+
+	for _, item := range items {
+		ok, err := Match(item, spec.Filter, fields)
+		if err != nil {
+			return nil, fmt.Errorf("match: %w", err)
+		}
+
+		if ok {
+			result = append(result, item)
+		}
+	}
+
+	if err := sortItems(result, spec.Sort, fields); err != nil {
+		return nil, fmt.Errorf("sort: %w", err)
+	}
+
+	return result, nil
+}
+
+// Match reports whether item matches all filters.
+func Match[T any](item T, filters []FilterSpec, fields Fields[T]) (bool, error) {
+	for _, filter := range filters {
+		accessor, ok := fields[filter.Field]
+		if !ok {
+			return false, fmt.Errorf("%w: %s", ErrFieldNotAllowed, filter.Field)
+		}
+
+		matched, err := matchValue(accessor(item), filter)
+		if err != nil {
+			return false, fmt.Errorf("field %s: %w", filter.Field, err)
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchValue(value any, filter FilterSpec) (bool, error) {
+	switch filter.Operator {
+	case Contains:
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.String {
+			return false, fmt.Errorf("%w: contains on %T", ErrInvalidSpec, value)
+		}
+
+		return strings.Contains(rv.String(), filter.Value), nil
+	case In:
+		for _, raw := range filter.Values() {
+			res, err := compareRaw(value, raw)
+			if err != nil {
+				return false, err
+			}
+
+			if res == 0 {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	case Eq, Ne, Gt, Gte, Lt, Lte:
+		res, err := compareRaw(value, filter.Value)
+		if err != nil {
+			return false, err
+		}
+
+		return matchComparison(filter.Operator, res), nil
+	}
+
+	return false, fmt.Errorf("%w: unknown operator %s", ErrInvalidSpec, filter.Operator)
+}
+
+func matchComparison(operator Operator, res int) bool {
+	switch operator {
+	case Eq:
+		return res == 0
+	case Ne:
+		return res != 0
+	case Gt:
+		return res > 0
+	case Gte:
+		return res >= 0
+	case Lt:
+		return res < 0
+	case Lte:
+		return res <= 0
+	case In, Contains:
+	}
+
+	return false
+}
+
+// compareRaw compares value with raw filter value parsed to the value type.
+func compareRaw(value any, raw string) (int, error) {
+	if tm, ok := value.(time.Time); ok {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidSpec, err)
+		}
+
+		return tm.Compare(parsed), nil
+	}
+
+	parsed, err := parseAs(reflect.ValueOf(value), raw)
+	if err != nil {
+		return 0, err
+	}
+
+	return compareValues(value, parsed)
+}
+
+func parseAs(rv reflect.Value, raw string) (any, error) {
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("%w: nil value", ErrInvalidSpec)
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+
+	//nolint:exhaustive
+	switch rv.Kind() {
+	case reflect.String:
+		parsed = raw
+	case reflect.Bool:
+		parsed, err = strconv.ParseBool(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err = strconv.ParseInt(raw, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err = strconv.ParseUint(raw, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		parsed, err = strconv.ParseFloat(raw, 64)
+	default:
+		return nil, fmt.Errorf("%w: unsupported type %s", ErrInvalidSpec, rv.Type())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSpec, err)
+	}
+
+	return parsed, nil
+}
+
+// compareValues compares two values of the same supported kind.
+// integer, unsigned and float values of different sizes are compared as the same kind.
+func compareValues(left any, right any) (int, error) {
+	var (
+		lv = reflect.ValueOf(left)
+		rv = reflect.ValueOf(right)
+	)
+
+	if !lv.IsValid() || !rv.IsValid() {
+		return 0, fmt.Errorf("%w: nil value", ErrInvalidSpec)
+	}
+
+	if ltm, ok := left.(time.Time); ok {
+		rtm, ok := right.(time.Time)
+		if !ok {
+			return 0, fmt.Errorf("%w: mismatched types %T and %T", ErrInvalidSpec, left, right)
+		}
+
+		return ltm.Compare(rtm), nil
+	}
+
+	kind := baseKind(lv.Kind())
+	if kind != baseKind(rv.Kind()) {
+		return 0, fmt.Errorf("%w: mismatched types %T and %T", ErrInvalidSpec, left, right)
+	}
+
+	//nolint:exhaustive
+	switch kind {
+	case reflect.String:
+		return cmp.Compare(lv.String(), rv.String()), nil
+	case reflect.Bool:
+		return compareBool(lv.Bool(), rv.Bool()), nil
+	case reflect.Int64:
+		return cmp.Compare(lv.Int(), rv.Int()), nil
+	case reflect.Uint64:
+		return cmp.Compare(lv.Uint(), rv.Uint()), nil
+	case reflect.Float64:
+		return cmp.Compare(lv.Float(), rv.Float()), nil
+	default:
+		return 0, fmt.Errorf("%w: unsupported type %T", ErrInvalidSpec, left)
+	}
+}
+
+// baseKind returns kind representing all sizes of integer, unsigned and float kinds.
+func baseKind(kind reflect.Kind) reflect.Kind {
+	//nolint:exhaustive
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return kind
+	}
+}
+
+func compareBool(left bool, right bool) int {
+	switch {
+	case left == right:
+		return 0
+	case right:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// sortItems stable sorts items in place by sort keys.
+func sortItems[T any](items []T, sorts []SortSpec, fields Fields[T]) error {
+	accessors := make([]func(item T) any, 0, len(sorts))
+
+	for _, sort := range sorts {
+		accessor, ok := fields[sort.Field]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrFieldNotAllowed, sort.Field)
+		}
+
+		accessors = append(accessors, accessor)
+	}
+
+	var sortErr error
+
+	slices.SortStableFunc(items, func(left T, right T) int {
+		for i, accessor := range accessors {
+			res, err := compareValues(accessor(left), accessor(right))
+			if err != nil {
+				sortErr = fmt.Errorf("field %s: %w", sorts[i].Field, err)
+
+				return 0
+			}
+
+			if sorts[i].Direction == Desc {
+				res = -res
+			}
+
+			if res != 0 {
+				return res
+			}
+		}
+
+		return 0
+	})
+
+	return sortErr
+}
@@ -0,0 +1,27 @@
+package spec
+
+type options struct {
+	SortFields   map[string]bool
+	FilterFields map[string]bool
+}
+
+// Option specify option for Parse.
+type Option func(opts *options)
+
+// WithSortFields fields allowed in sort parameter (default none).
+func WithSortFields(fields ...string) Option {
+	return func(opts *options) {
+		for _, field := range fields {
+			opts.SortFields[field] = true
+		}
+	}
+}
+
+// WithFilterFields fields allowed in filter parameters (default none).
+func WithFilterFields(fields ...string) Option {
+	return func(opts *options) {
+		for _, field := range fields {
+			opts.FilterFields[field] = true
+		}
+	}
+}
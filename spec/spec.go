@@ -0,0 +1,267 @@
+// Package spec provides sort and filter specification shared by queryer implementations.
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+const (
+	sortParam    = "sort"
+	filterParam  = "filter"
+	listSplitter = ","
+)
+
+var (
+	// ErrInvalidSpec is returned for malformed sort or filter and for filter value of wrong type.
+	ErrInvalidSpec = errors.New("invalid spec")
+	// ErrFieldNotAllowed is returned for field missing in allow-list or in columns/fields mapping.
+	ErrFieldNotAllowed = errors.New("field not allowed")
+)
+
+// Direction specifies sort direction.
+type Direction string
+
+const (
+	// Asc ascending order.
+	Asc Direction = "asc"
+	// Desc descending order, specified with minus prefix in sort parameter.
+	Desc Direction = "desc"
+)
+
+// Operator specifies filter comparison operator.
+type Operator string
+
+const (
+	// Eq equal to value, used for filter without operator.
+	Eq Operator = "eq"
+	// Ne not equal to value.
+	Ne Operator = "ne"
+	// Gt greater than value.
+	Gt Operator = "gt"
+	// Gte greater than or equal to value.
+	Gte Operator = "gte"
+	// Lt less than value.
+	Lt Operator = "lt"
+	// Lte less than or equal to value.
+	Lte Operator = "lte"
+	// In equal to one of comma separated values.
+	In Operator = "in"
+	// Contains string value contains substring.
+	Contains Operator = "contains"
+)
+
+func (o Operator) valid() bool {
+	switch o {
+	case Eq, Ne, Gt, Gte, Lt, Lte, In, Contains:
+		return true
+	}
+
+	return false
+}
+
+// SortSpec specifies one sort key.
+type SortSpec struct {
+	Field     string
+	Direction Direction
+}
+
+// FilterSpec specifies one filter condition.
+type FilterSpec struct {
+	Field    string
+	Operator Operator
+	Value    string
+}
+
+// Values returns comma separated values for In operator, otherwise single value.
+func (f FilterSpec) Values() []string {
+	if f.Operator == In {
+		return strings.Split(f.Value, listSplitter)
+	}
+
+	return []string{f.Value}
+}
+
+// Spec specifies sort keys in priority order and filters combined with AND.
+type Spec struct {
+	Sort   []SortSpec
+	Filter []FilterSpec
+}
+
+// ParseQuery parses spec from raw url query string.
+func ParseQuery(rawQuery string, opts ...Option) (Spec, error) {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return Spec{}, fmt.Errorf("%w: %w", ErrInvalidSpec, err)
+	}
+
+	return Parse(values, opts...)
+}
+
+// Parse parses spec from url query values like sort=-created,id&filter[status]=open&filter[id][gte]=10.
+// minus prefix means descending order, filter without operator means Eq.
+// fields are validated against allow-lists from options, other query parameters are ignored.
+func Parse(values url.Values, opts ...Option) (Spec, error) {
+	defaultOptions := options{
+		SortFields:   make(map[string]bool),
+		FilterFields: make(map[string]bool),
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	sorts, err := parseSort(values[sortParam], defaultOptions.SortFields)
+	if err != nil {
+		return Spec{}, fmt.Errorf("parse sort: %w", err)
+	}
+
+	filters, err := parseFilter(values, defaultOptions.FilterFields)
+	if err != nil {
+		return Spec{}, fmt.Errorf("parse filter: %w", err)
+	}
+
+	return Spec{
+		Sort:   sorts,
+		Filter: filters,
+	}, nil
+}
+
+func parseSort(params []string, allowed map[string]bool) ([]SortSpec, error) {
+	var sorts []SortSpec
+
+	for _, param := range params {
+		for field := range strings.SplitSeq(param, listSplitter) {
+			direction := Asc
+
+			switch {
+			case strings.HasPrefix(field, "-"):
+				field, direction = field[1:], Desc
+			case strings.HasPrefix(field, "+"):
+				field = field[1:]
+			}
+
+			if field == "" {
+				return nil, fmt.Errorf("%w: empty sort field", ErrInvalidSpec)
+			}
+
+			if !allowed[field] {
+				return nil, fmt.Errorf("%w: %s", ErrFieldNotAllowed, field)
+			}
+
+			if slices.ContainsFunc(sorts, func(s SortSpec) bool { return s.Field == field }) {
+				return nil, fmt.Errorf("%w: duplicate sort field %s", ErrInvalidSpec, field)
+			}
+
+			sorts = append(sorts, SortSpec{
+				Field:     field,
+				Direction: direction,
+			})
+		}
+	}
+
+	return sorts, nil
+}
+
+// parseFilter parses filter[field] and filter[field][op] parameters sorted by key.
+func parseFilter(values url.Values, allowed map[string]bool) ([]FilterSpec, error) {
+	var filters []FilterSpec
+
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		if !strings.HasPrefix(key, filterParam+"[") {
+			continue
+		}
+
+		field, operator, err := parseFilterKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed[field] {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotAllowed, field)
+		}
+
+		for _, value := range values[key] {
+			filters = append(filters, FilterSpec{
+				Field:    field,
+				Operator: operator,
+				Value:    value,
+			})
+		}
+	}
+
+	return filters, nil
+}
+
+// parseFilterKey parses filter[field] or filter[field][op] key.
+func parseFilterKey(key string) (string, Operator, error) {
+	rest := strings.TrimPrefix(key, filterParam+"[")
+
+	field, rest, ok := strings.Cut(rest, "]")
+	if !ok || field == "" {
+		return "", "", fmt.Errorf("%w: malformed filter %s", ErrInvalidSpec, key)
+	}
+
+	if rest == "" {
+		return field, Eq, nil
+	}
+
+	if !strings.HasPrefix(rest, "[") || !strings.HasSuffix(rest, "]") {
+		return "", "", fmt.Errorf("%w: malformed filter %s", ErrInvalidSpec, key)
+	}
+
+	operator := Operator(rest[1 : len(rest)-1])
+	if !operator.valid() {
+		return "", "", fmt.Errorf("%w: unknown operator %s", ErrInvalidSpec, operator)
+	}
+
+	return field, operator, nil
+}
+
+// Values returns url query values representation of spec parsable by Parse.
+func (s Spec) Values() url.Values {
+	values := make(url.Values)
+
+	if len(s.Sort) > 0 {
+		fields := make([]string, 0, len(s.Sort))
+
+		for _, sort := range s.Sort {
+			if sort.Direction == Desc {
+				fields = append(fields, "-"+sort.Field)
+
+				continue
+			}
+
+			fields = append(fields, sort.Field)
+		}
+
+		values.Set(sortParam, strings.Join(fields, listSplitter))
+	}
+
+	for _, filter := range s.Filter {
+		key := fmt.Sprintf("%s[%s][%s]", filterParam, filter.Field, filter.Operator)
+		if filter.Operator == Eq {
+			key = fmt.Sprintf("%s[%s]", filterParam, filter.Field)
+		}
+
+		values.Add(key, filter.Value)
+	}
+
+	return values
+}
+
+// Encode returns url query string representation of spec.
+func (s Spec) Encode() string {
+	return s.Values().Encode()
+}
+
+// Hash returns spec hash suitable for page token filter hash.
+func (s Spec) Hash() string {
+	return token.HashFilter(s.Encode())
+}
@@ -0,0 +1,284 @@
+package spec_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator/spec"
+)
+
+var parseOptions = []spec.Option{
+	spec.WithSortFields("created", "id"),
+	spec.WithFilterFields("status", "id", "name"),
+}
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := spec.ParseQuery(
+		"sort=-created,id&filter[status]=open&filter[id][gte]=10&filter[name][in]=a,b&page=2",
+		parseOptions...,
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, spec.Spec{
+		Sort: []spec.SortSpec{
+			{Field: "created", Direction: spec.Desc},
+			{Field: "id", Direction: spec.Asc},
+		},
+		Filter: []spec.FilterSpec{
+			{Field: "id", Operator: spec.Gte, Value: "10"},
+			{Field: "name", Operator: spec.In, Value: "a,b"},
+			{Field: "status", Operator: spec.Eq, Value: "open"},
+		},
+	}, parsed)
+
+	require.Equal(t, []string{"a", "b"}, parsed.Filter[1].Values())
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	for query, expectedErr := range map[string]error{
+		"sort=-secret":               spec.ErrFieldNotAllowed,
+		"filter[secret]=1":           spec.ErrFieldNotAllowed,
+		"sort=id,":                   spec.ErrInvalidSpec,
+		"sort=id,-id":                spec.ErrInvalidSpec,
+		"filter[id][between]=1":      spec.ErrInvalidSpec,
+		"filter[id]x=1":              spec.ErrInvalidSpec,
+		"filter[]=1":                 spec.ErrInvalidSpec,
+		"filter[status]=open&%zz=1":  spec.ErrInvalidSpec,
+		"filter[status][eq]=open&=;": spec.ErrInvalidSpec,
+	} {
+		t.Run(query, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := spec.ParseQuery(query, parseOptions...)
+
+			require.ErrorIs(t, err, expectedErr)
+			require.Empty(t, parsed)
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := spec.ParseQuery("sort=-created&filter[status]=open&filter[id][lt]=5", parseOptions...)
+
+	require.NoError(t, err)
+
+	reparsed, err := spec.ParseQuery(parsed.Encode(), parseOptions...)
+
+	require.NoError(t, err)
+	require.Equal(t, parsed, reparsed)
+	require.Equal(t, parsed.Hash(), reparsed.Hash())
+
+	other, err := spec.ParseQuery("sort=-created&filter[status]=closed", parseOptions...)
+
+	require.NoError(t, err)
+	require.NotEqual(t, parsed.Hash(), other.Hash())
+}
+
+func TestSQL(t *testing.T) {
+	t.Parallel()
+
+	var (
+		columns = map[string]string{
+			"created": "created_at",
+			"id":      "id",
+			"status":  "status",
+			"name":    "name",
+		}
+		filter = spec.Spec{
+			Sort: []spec.SortSpec{
+				{Field: "created", Direction: spec.Desc},
+				{Field: "id", Direction: spec.Asc},
+			},
+			Filter: []spec.FilterSpec{
+				{Field: "id", Operator: spec.Gte, Value: "10"},
+				{Field: "name", Operator: spec.In, Value: "a,b"},
+				{Field: "status", Operator: spec.Contains, Value: "op%"},
+			},
+		}
+	)
+
+	orderBy, err := filter.SQLOrderBy(columns)
+
+	require.NoError(t, err)
+	require.Equal(t, "created_at DESC, id ASC", orderBy)
+
+	where, args, err := filter.SQLWhere(columns, 2)
+
+	require.NoError(t, err)
+	require.Equal(t, "id >= $3 AND name IN ($4, $5) AND status LIKE $6", where)
+	require.Equal(t, []any{"10", "a", "b", `%op\%%`}, args)
+
+	where, args, err = spec.Spec{}.SQLWhere(columns, 0)
+
+	require.NoError(t, err)
+	require.Equal(t, "TRUE", where)
+	require.Empty(t, args)
+
+	_, err = filter.SQLOrderBy(map[string]string{"id": "id"})
+
+	require.ErrorIs(t, err, spec.ErrFieldNotAllowed)
+}
+
+type item struct {
+	ID      int
+	Status  string
+	Created time.Time
+}
+
+func itemFields() spec.Fields[item] {
+	return spec.Fields[item]{
+		"id":      func(i item) any { return i.ID },
+		"status":  func(i item) any { return i.Status },
+		"created": func(i item) any { return i.Created },
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	var (
+		day   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		items = []item{
+			{ID: 1, Status: "open", Created: day},
+			{ID: 2, Status: "closed", Created: day.Add(time.Hour)},
+			{ID: 3, Status: "open", Created: day.Add(time.Hour)},
+			{ID: 4, Status: "reopened", Created: day.Add(2 * time.Hour)},
+		}
+	)
+
+	filtered, err := spec.Apply(items, spec.Spec{
+		Sort: []spec.SortSpec{
+			{Field: "created", Direction: spec.Desc},
+			{Field: "id", Direction: spec.Asc},
+		},
+		Filter: []spec.FilterSpec{
+			{Field: "status", Operator: spec.Contains, Value: "open"},
+			{Field: "created", Operator: spec.Lt, Value: "2024-01-01T02:00:00Z"},
+		},
+	}, itemFields())
+
+	require.NoError(t, err)
+	require.Equal(t, []item{items[2], items[0]}, filtered)
+
+	filtered, err = spec.Apply(items, spec.Spec{
+		Filter: []spec.FilterSpec{
+			{Field: "id", Operator: spec.In, Value: "2,4"},
+		},
+	}, itemFields())
+
+	require.NoError(t, err)
+	require.Equal(t, []item{items[1], items[3]}, filtered)
+}
+
+func TestApplyErrors(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Spec        spec.Spec
+		ExpectedErr error
+	}{
+		"unknown filter field": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "name", Operator: spec.Eq}}},
+			ExpectedErr: spec.ErrFieldNotAllowed,
+		},
+		"unknown sort field": {
+			Spec:        spec.Spec{Sort: []spec.SortSpec{{Field: "name"}}},
+			ExpectedErr: spec.ErrFieldNotAllowed,
+		},
+		"invalid number": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "id", Operator: spec.Eq, Value: "x"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+		"contains on number": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "id", Operator: spec.Contains, Value: "1"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+		"nil filter value": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "nil", Operator: spec.Eq, Value: "1"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+		"nil in value": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "nil", Operator: spec.In, Value: "1,2"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+		"nil contains value": {
+			Spec:        spec.Spec{Filter: []spec.FilterSpec{{Field: "nil", Operator: spec.Contains, Value: "1"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+		"nil sort value": {
+			Spec:        spec.Spec{Sort: []spec.SortSpec{{Field: "nil"}}},
+			ExpectedErr: spec.ErrInvalidSpec,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := itemFields()
+			fields["nil"] = func(i item) any { return nil }
+
+			filtered, err := spec.Apply([]item{{ID: 1}, {ID: 2}}, tc.Spec, fields)
+
+			require.ErrorIs(t, err, tc.ExpectedErr)
+			require.Nil(t, filtered)
+		})
+	}
+}
+
+func TestApplySortInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, accessor := range map[string]func(i item) any{
+		"nil time": func(i item) any {
+			if i.ID == 1 {
+				return nil
+			}
+
+			return day
+		},
+		"time nil": func(i item) any {
+			if i.ID == 1 {
+				return day
+			}
+
+			return nil
+		},
+		"mixed time": func(i item) any {
+			if i.ID == 1 {
+				return day
+			}
+
+			return i.ID
+		},
+		"mixed kind": func(i item) any {
+			if i.ID == 1 {
+				return i.ID
+			}
+
+			return "2"
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := itemFields()
+			fields["value"] = accessor
+
+			filtered, err := spec.Apply([]item{{ID: 1}, {ID: 2}}, spec.Spec{
+				Sort: []spec.SortSpec{{Field: "value"}},
+			}, fields)
+
+			require.ErrorIs(t, err, spec.ErrInvalidSpec)
+			require.Nil(t, filtered)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQLOrderBy returns ORDER BY clause body like "created_at DESC, id ASC".
+// columns maps spec fields to sql columns, unmapped field is an error.
+// returns empty string for empty sort.
+func (s Spec) SQLOrderBy(columns map[string]string) (string, error) {
+	parts := make([]string, 0, len(s.Sort))
+
+	for _, sort := range s.Sort {
+		column, ok := columns[sort.Field]
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrFieldNotAllowed, sort.Field)
+		}
+
+		direction := "ASC"
+		if sort.Direction == Desc {
+			direction = "DESC"
+		}
+
+		parts = append(parts, column+" "+direction)
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// SQLWhere returns WHERE clause body with postgres style placeholders and its arguments.
+// placeholders are numbered after argOffset, so clause can follow other query arguments.
+// columns maps spec fields to sql columns, unmapped field is an error.
+// returns TRUE for empty filter.
+func (s Spec) SQLWhere(columns map[string]string, argOffset int) (string, []any, error) {
+	var (
+		parts = make([]string, 0, len(s.Filter))
+		args  = make([]any, 0, len(s.Filter))
+	)
+
+	placeholder := func(arg any) string {
+		args = append(args, arg)
+
+		return fmt.Sprintf("$%d", argOffset+len(args))
+	}
+
+	for _, filter := range s.Filter {
+		column, ok := columns[filter.Field]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %s", ErrFieldNotAllowed, filter.Field)
+		}
+
+		switch filter.Operator {
+		case In:
+			values := filter.Values()
+			placeholders := make([]string, 0, len(values))
+
+			for _, value := range values {
+				placeholders = append(placeholders, placeholder(value))
+			}
+
+			parts = append(parts, fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")))
+		case Contains:
+			parts = append(parts, fmt.Sprintf("%s LIKE %s", column, placeholder("%"+escapeLike(filter.Value)+"%")))
+		case Eq, Ne, Gt, Gte, Lt, Lte:
+			parts = append(parts, fmt.Sprintf("%s %s %s", column, sqlOperator(filter.Operator), placeholder(filter.Value)))
+		default:
+			return "", nil, fmt.Errorf("%w: unknown operator %s", ErrInvalidSpec, filter.Operator)
+		}
+	}
+
+	if len(parts) == 0 {
+		return "TRUE", nil, nil
+	}
+
+	return strings.Join(parts, " AND "), args, nil
+}
+
+func sqlOperator(operator Operator) string {
+	switch operator {
+	case Eq:
+		return "="
+	case Ne:
+		return "<>"
+	case Gt:
+		return ">"
+	case Gte:
+		return ">="
+	case Lt:
+		return "<"
+	case Lte:
+		return "<="
+	case In, Contains:
+	}
+
+	return ""
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
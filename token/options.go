@@ -0,0 +1,36 @@
+package token
+
+import (
+	"time"
+)
+
+type options struct {
+	EncryptionKey []byte
+	TTL           time.Duration
+	Version       int
+}
+
+// Option specify option for Codec.
+type Option func(opts *options)
+
+// WithEncryption enables AES-GCM token encryption, key should be 16, 24 or 32 bytes long.
+func WithEncryption(key []byte) Option {
+	return func(opts *options) {
+		opts.EncryptionKey = key
+	}
+}
+
+// WithTTL token expiration time (default no expiration).
+func WithTTL(ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.TTL = ttl
+	}
+}
+
+// WithVersion token format version (default 1).
+// tokens with another version are rejected.
+func WithVersion(version int) Option {
+	return func(opts *options) {
+		opts.Version = version
+	}
+}
@@ -0,0 +1,206 @@
+// Package token provides opaque tamper-proof page tokens.
+package token
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultVersion = 1
+)
+
+var (
+	// ErrInvalidToken is returned for malformed or tampered token.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrExpiredToken is returned for expired token.
+	ErrExpiredToken = errors.New("expired token")
+	// ErrFilterMismatch is returned for token issued for another filter.
+	ErrFilterMismatch = errors.New("token filter mismatch")
+)
+
+// State represents page state encoded into token.
+// Page is used by offset paginators, Cursor contains JSON encoded cursor for cursor paginators.
+// ExpiresAt is unix time in milliseconds.
+type State struct {
+	Version    int             `json:"v"`
+	Page       int             `json:"p,omitempty"`
+	Cursor     json.RawMessage `json:"c,omitempty"`
+	Size       int             `json:"s,omitempty"`
+	FilterHash string          `json:"f,omitempty"`
+	ExpiresAt  int64           `json:"e,omitempty"`
+}
+
+// Codec encodes page state into opaque base64 token signed with HMAC-SHA256
+// and optionally encrypted with AES-GCM.
+type Codec struct {
+	signKey []byte
+	aead    cipher.AEAD
+	ttl     time.Duration
+	version int
+}
+
+// NewCodec constructs new Codec.
+func NewCodec(signKey []byte, opts ...Option) (*Codec, error) {
+	if len(signKey) == 0 {
+		return nil, errors.New("empty sign key")
+	}
+
+	defaultOptions := options{
+		Version: defaultVersion,
+	}
+
+	for _, o := range opts {
+		o(&defaultOptions)
+	}
+
+	codec := &Codec{
+		signKey: signKey,
+		ttl:     defaultOptions.TTL,
+		version: defaultOptions.Version,
+	}
+
+	if len(defaultOptions.EncryptionKey) > 0 {
+		block, err := aes.NewCipher(defaultOptions.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("aes new cipher: %w", err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("new gcm: %w", err)
+		}
+
+		codec.aead = aead
+	}
+
+	return codec, nil
+}
+
+// Encode returns token for the state.
+// Version and ExpiresAt are set by codec.
+func (c *Codec) Encode(state State) (string, error) {
+	state.Version = c.version
+	state.ExpiresAt = 0
+
+	if c.ttl > 0 {
+		state.ExpiresAt = time.Now().Add(c.ttl).UnixMilli()
+	}
+
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("marshal state: %w", err)
+	}
+
+	if c.aead != nil {
+		payload, err = c.encrypt(payload)
+		if err != nil {
+			return "", fmt.Errorf("encrypt: %w", err)
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append(payload, c.sign(payload)...)), nil
+}
+
+// Decode returns state from the token.
+// verifies signature, version, expiration and filter hash.
+func (c *Codec) Decode(token string, filterHash string) (State, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return State{}, fmt.Errorf("%w: decode base64: %s", ErrInvalidToken, err.Error())
+	}
+
+	if len(raw) < sha256.Size {
+		return State{}, fmt.Errorf("%w: too short", ErrInvalidToken)
+	}
+
+	var (
+		payload   = raw[:len(raw)-sha256.Size]
+		signature = raw[len(raw)-sha256.Size:]
+	)
+
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return State{}, fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+	}
+
+	if c.aead != nil {
+		payload, err = c.decrypt(payload)
+		if err != nil {
+			return State{}, fmt.Errorf("%w: decrypt: %s", ErrInvalidToken, err.Error())
+		}
+	}
+
+	var state State
+	if err := json.Unmarshal(payload, &state); err != nil {
+		return State{}, fmt.Errorf("%w: unmarshal state: %s", ErrInvalidToken, err.Error())
+	}
+
+	if state.Version != c.version {
+		return State{}, fmt.Errorf("%w: version %d", ErrInvalidToken, state.Version)
+	}
+
+	if state.ExpiresAt != 0 && time.Now().UnixMilli() > state.ExpiresAt {
+		return State{}, ErrExpiredToken
+	}
+
+	if state.FilterHash != filterHash {
+		return State{}, ErrFilterMismatch
+	}
+
+	return state, nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.signKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// encrypt returns nonce followed by encrypted payload.
+func (c *Codec) encrypt(payload []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("read nonce: %w", err)
+	}
+
+	return c.aead.Seal(nonce, nonce, payload, nil), nil
+}
+
+func (c *Codec) decrypt(payload []byte) ([]byte, error) {
+	if len(payload) < c.aead.NonceSize() {
+		return nil, errors.New("payload too short")
+	}
+
+	var (
+		nonce      = payload[:c.aead.NonceSize()]
+		ciphertext = payload[c.aead.NonceSize():]
+	)
+
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("aead open: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// HashFilter returns hash of filter parts for State.FilterHash.
+func HashFilter(parts ...string) string {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,143 @@
+package token_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Mikhalevich/paginator/token"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	for name, opts := range map[string][]token.Option{
+		"signed":    nil,
+		"encrypted": {token.WithEncryption([]byte("0123456789abcdef"))},
+		"ttl":       {token.WithTTL(time.Minute)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			codec, err := token.NewCodec([]byte("secret"), opts...)
+
+			require.NoError(t, err)
+
+			pageToken, err := codec.Encode(token.State{
+				Page:       5,
+				Size:       20,
+				FilterHash: token.HashFilter("status", "open"),
+			})
+
+			require.NoError(t, err)
+
+			state, err := codec.Decode(pageToken, token.HashFilter("status", "open"))
+
+			require.NoError(t, err)
+			require.Equal(t, 1, state.Version)
+			require.Equal(t, 5, state.Page)
+			require.Equal(t, 20, state.Size)
+		})
+	}
+}
+
+func TestDecodeTampered(t *testing.T) {
+	t.Parallel()
+
+	codec, err := token.NewCodec([]byte("secret"))
+
+	require.NoError(t, err)
+
+	pageToken, err := codec.Encode(token.State{Page: 5})
+
+	require.NoError(t, err)
+
+	anotherCodec, err := token.NewCodec([]byte("another secret"))
+
+	require.NoError(t, err)
+
+	_, err = anotherCodec.Decode(pageToken, "")
+
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+
+	tampered := "A" + pageToken[1:]
+	if strings.HasPrefix(pageToken, "A") {
+		tampered = "B" + pageToken[1:]
+	}
+
+	_, err = codec.Decode(tampered, "")
+
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+
+	_, err = codec.Decode("not a token", "")
+
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+}
+
+func TestDecodeVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	codec, err := token.NewCodec([]byte("secret"))
+
+	require.NoError(t, err)
+
+	pageToken, err := codec.Encode(token.State{Page: 5})
+
+	require.NoError(t, err)
+
+	nextVersionCodec, err := token.NewCodec([]byte("secret"), token.WithVersion(2))
+
+	require.NoError(t, err)
+
+	_, err = nextVersionCodec.Decode(pageToken, "")
+
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+}
+
+func TestDecodeExpired(t *testing.T) {
+	t.Parallel()
+
+	codec, err := token.NewCodec([]byte("secret"), token.WithTTL(time.Millisecond))
+
+	require.NoError(t, err)
+
+	pageToken, err := codec.Encode(token.State{Page: 5})
+
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond * 5)
+
+	_, err = codec.Decode(pageToken, "")
+
+	require.ErrorIs(t, err, token.ErrExpiredToken)
+}
+
+func TestDecodeFilterMismatch(t *testing.T) {
+	t.Parallel()
+
+	codec, err := token.NewCodec([]byte("secret"))
+
+	require.NoError(t, err)
+
+	pageToken, err := codec.Encode(token.State{Page: 5, FilterHash: token.HashFilter("open")})
+
+	require.NoError(t, err)
+
+	_, err = codec.Decode(pageToken, token.HashFilter("closed"))
+
+	require.ErrorIs(t, err, token.ErrFilterMismatch)
+}
+
+func TestNewCodecInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := token.NewCodec(nil)
+
+	require.Error(t, err)
+
+	_, err = token.NewCodec([]byte("secret"), token.WithEncryption([]byte("short")))
+
+	require.Error(t, err)
+}
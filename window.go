@@ -0,0 +1,81 @@
+package paginator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Window represents arbitrary range of items.
+// BottomIndex and TopIndex are indexes of the first and the last window items (zero for empty window).
+// Limit is the number of items expected in the window according to total count.
+// TotalUnknown is set when range is requested without count and
+// the last item is not reached yet, ItemTotalCount is zero in this case.
+// TotalIsEstimate is set when ItemTotalCount is calculated from estimated total count.
+// Inconsistent is set when data length doesn't match Limit.
+type Window[T any] struct {
+	Data            []T
+	Offset          int
+	Limit           int
+	BottomIndex     int
+	TopIndex        int
+	ItemTotalCount  int
+	TotalUnknown    bool
+	TotalIsEstimate bool
+	Inconsistent    bool
+}
+
+// IsEmpty returns true if window has no data.
+func (w *Window[T]) IsEmpty() bool {
+	return len(w.Data) == 0
+}
+
+// HasNext returns true if there are items after the window.
+func (w *Window[T]) HasNext() bool {
+	if w.TotalUnknown {
+		return true
+	}
+
+	return w.Offset+len(w.Data) < w.ItemTotalCount
+}
+
+// Range returns window of items starting from offset.
+// limit is clamped to the configured page size bounds,
+// total count is requested the same way as for Page (WithoutCount, EstimatingQueryer,
+// QueryCounter and WithConcurrentQuery are respected) and offset past the last item
+// is handled according to out of range policy (clamp moves window to the end of collection).
+func (p *Paginator[T]) Range(ctx context.Context, offset int, limit int) (*Window[T], error) {
+	if offset < 0 || limit <= 0 {
+		return nil, fmt.Errorf("%w: offset %d limit %d", ErrInvalidRange, offset, limit)
+	}
+
+	limit = p.boundPageSize(limit)
+
+	window, err := p.fetchRange(ctx, offset, limit)
+
+	var rangeErr *RangeError
+	if errors.As(err, &rangeErr) && !rangeErr.TotalUnknown {
+		return p.outOfRangeWindow(ctx, offset, limit, rangeErr.ItemTotalCount)
+	}
+
+	return window, err
+}
+
+// outOfRangeWindow returns window for offset past the last item according to out of range policy.
+func (p *Paginator[T]) outOfRangeWindow(ctx context.Context, offset int, limit int, count int) (*Window[T], error) {
+	switch p.opts.OutOfRangePolicy {
+	case OutOfRangeClamp:
+		return p.rangeByCount(ctx, max(count-limit, 0), limit, count)
+	case OutOfRangeEmpty:
+		return &Window[T]{
+			Offset:         offset,
+			ItemTotalCount: count,
+		}, nil
+	case OutOfRangeError:
+	}
+
+	return nil, &RangeError{
+		Offset:         offset,
+		ItemTotalCount: count,
+	}
+}